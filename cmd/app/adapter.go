@@ -1,35 +1,44 @@
 package app
 
 import (
-	"crypto/tls"
-	"crypto/x509"
+	"context"
+	"errors"
 	"fmt"
-	"io/ioutil"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
+	adapterProtos "scow-crane-adapter/gen/adapter"
 	protos "scow-crane-adapter/gen/go"
 	"scow-crane-adapter/pkg/monitor"
 	"scow-crane-adapter/pkg/services/account"
 	"scow-crane-adapter/pkg/services/app"
 	"scow-crane-adapter/pkg/services/config"
 	"scow-crane-adapter/pkg/services/job"
+	"scow-crane-adapter/pkg/services/maintenance"
+	"scow-crane-adapter/pkg/services/reservation"
 	"scow-crane-adapter/pkg/services/user"
 	"scow-crane-adapter/pkg/services/version"
 	"scow-crane-adapter/pkg/utils"
 )
 
 var (
-	FlagConfigFilePath string
-	GConfig            utils.Config
-	defaultMonitorPort = 8973
+	FlagConfigFilePath     string
+	GConfig                utils.Config
+	defaultMonitorPort     = 8973
+	defaultStateDir        = "data"
+	defaultShutdownTimeout = 30 * time.Second
+	metricsShutdownTimeout = 5 * time.Second
 )
 
 func NewAdapterCommand() *cobra.Command {
@@ -70,91 +79,202 @@ func NewAdapterCommand() *cobra.Command {
 	rootCmd.PersistentFlags().StringVarP(&FlagConfigFilePath, "config", "c", "", "Path to configuration file")
 
 	// Other flags
-	rootCmd.PersistentFlags().IntP("bind-port", "p", 5000, "Binding address of adapter")
-	viper.BindPFlag("bind-addr", rootCmd.PersistentFlags().Lookup("addr"))
+	rootCmd.PersistentFlags().StringP("bind-addr", "a", "", "Binding address of adapter, listen on all addresses if empty")
+	viper.BindPFlag("bind-addr", rootCmd.PersistentFlags().Lookup("bind-addr"))
+
+	rootCmd.PersistentFlags().IntP("bind-port", "p", 8972, "Binding port of adapter")
+	viper.BindPFlag("bind-port", rootCmd.PersistentFlags().Lookup("bind-port"))
+
+	rootCmd.PersistentFlags().String("unix-socket", "", "Path of unix domain socket to listen on in addition to the port")
+	viper.BindPFlag("unix-socket.path", rootCmd.PersistentFlags().Lookup("unix-socket"))
 
 	rootCmd.PersistentFlags().StringP("log-level", "l", "info", "Log level")
 	viper.BindPFlag("log-level", rootCmd.PersistentFlags().Lookup("log-level"))
 
+	// 子命令
+	rootCmd.AddCommand(newExportCommand(), newImportCommand())
+
 	return rootCmd
 }
 
+// initAdapter 初始化鹤思客户端、适配器状态存储和身份源，服务和子命令共用
+func initAdapter() {
+	// 初始化CraneCtld客户端及鹤思配置文件、MongoDB配置文件
+	utils.InitClientAndConfig(GConfig.CraneTls, GConfig.CraneClient)
+
+	// 初始化适配器自身的状态存储
+	stateDir := GConfig.StateDir
+	if stateDir == "" {
+		stateDir = defaultStateDir
+	}
+	if err := utils.InitStateStore(stateDir); err != nil {
+		logrus.Fatalf("failed to init state store: %s", err)
+	}
+
+	// 初始化查询用户uid的身份源
+	if err := utils.InitIdentityProvider(GConfig.Identity); err != nil {
+		logrus.Fatalf("failed to init identity provider: %s", err)
+	}
+
+	// 初始化分区发现
+	utils.InitPartitionDiscovery(GConfig.Partition)
+
+	// 在作业节点上执行命令的超时
+	utils.SetCommandRunnerConfig(GConfig.CommandRunner)
+
+	// 删除账户或用户时取消作业后的等待时间
+	utils.SetJobCancelConfig(GConfig.JobCancel)
+
+	// 分区节点规格中返回的节点特性
+	utils.SetNodeFeatures(GConfig.NodeFeatures)
+}
+
 func Run() {
-	// 初始化CraneCtld客户端及鹤思配置文件、MongoDB客户端及配置文件
-	utils.InitClientAndConfig()
+	initAdapter()
+
+	// 收到SIGTERM或SIGINT时ctx结束，停止后台任务并退出
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
 
 	// 启动系统指标采集
-	monitor.StartSystemMetricsCollector()
+	monitor.StartSystemMetricsCollector(ctx)
+
+	// 启动节点排空计划的执行
+	utils.StartNodeDrainScheduler(ctx)
 
 	monitorPort := GConfig.Monitor.Port
 	if monitorPort == 0 {
 		monitorPort = defaultMonitorPort
 	}
-	monitorPortString := fmt.Sprintf(":%d", monitorPort)
+	monitorAddr := listenAddress(GConfig.Monitor.BindAddr, monitorPort)
 	// 暴露Prometheus指标端点
+	http.Handle("/metrics", monitor.MetricsHandlerWithMonitoring(promhttp.Handler()))
+	http.Handle("/healthz", monitor.HealthzHandler())
+	http.Handle("/readyz", monitor.ReadyzHandler())
+	metricsServer := &http.Server{Addr: monitorAddr}
 	go func() {
-		http.Handle("/metrics", monitor.MetricsHandlerWithMonitoring(promhttp.Handler()))
-		http.ListenAndServe(monitorPortString, nil)
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logrus.Errorf("metrics server quitting: %s", err)
+		}
 	}()
 
-	s := grpc.NewServer(
-		grpc.MaxRecvMsgSize(1024*1024*1024), // 最大接受size 1GB
-		grpc.MaxSendMsgSize(1024*1024*1024), // 最大发送size 1GB
-		grpc.UnaryInterceptor(monitor.MetricsInterceptor()),
-	)
+	// 按客户端授权调用
+	if err := utils.InitAuthorization(GConfig.Authorization); err != nil {
+		logrus.Fatalf("failed to init authorization: %s", err)
+	}
+
+	serverOptions := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(1024 * 1024 * 1024), // 最大接受size 1GB
+		grpc.MaxSendMsgSize(1024 * 1024 * 1024), // 最大发送size 1GB
+		grpc.ChainUnaryInterceptor(monitor.MetricsInterceptor(), utils.AuthorizationUnaryInterceptor()),
+		grpc.StreamInterceptor(utils.AuthorizationStreamInterceptor()),
+	}
 
 	if GConfig.Ssl.Enabled {
-		caCertPath, adapterCertPath, adapterPrivateKeyPath := GetCertPath()
-		logrus.Tracef("caCertPath, adapterCertPath, adapterPrivateKeyPath: %s, %s, %s", caCertPath, adapterCertPath, adapterPrivateKeyPath)
-		pair, err := tls.LoadX509KeyPair(adapterCertPath, adapterPrivateKeyPath)
-		if err != nil {
-			fmt.Println("LoadX509KeyPair error", err)
-			return
-		}
-		// 创建一组根证书
-		certPool := x509.NewCertPool()
-		ca, err := ioutil.ReadFile(caCertPath)
+		tlsConfig, err := loadServerTlsConfig()
 		if err != nil {
-			fmt.Println("read ca pem error ", err)
-			return
-		}
-		// 解析证书
-		if ok := certPool.AppendCertsFromPEM(ca); !ok {
-			fmt.Println("AppendCertsFromPEM error ")
+			fmt.Println(err)
 			return
 		}
-
-		cred := credentials.NewTLS(&tls.Config{
-			Certificates: []tls.Certificate{pair},
-			ClientAuth:   tls.RequireAndVerifyClientCert,
-			ClientCAs:    certPool,
-		})
-
-		s = grpc.NewServer(
-			grpc.MaxRecvMsgSize(1024*1024*1024), // 最大接受size 1GB
-			grpc.MaxSendMsgSize(1024*1024*1024), // 最大发送size 1GB
-			grpc.UnaryInterceptor(monitor.MetricsInterceptor()),
-			grpc.Creds(cred),
-		)
+		// 证书和CA在重新加载配置时替换
+		serverTlsConfig.Store(tlsConfig)
+		serverOptions = append(serverOptions, grpc.Creds(serverCredentials()))
 	}
+	s := grpc.NewServer(serverOptions...)
 
 	// 注册服务
 	protos.RegisterJobServiceServer(s, &job.ServerJob{})
-	protos.RegisterAccountServiceServer(s, &account.ServerAccount{})
-	protos.RegisterConfigServiceServer(s, &config.ServerConfig{})
-	protos.RegisterUserServiceServer(s, &user.ServerUser{})
+	accountServer := &account.ServerAccount{}
+	protos.RegisterAccountServiceServer(s, accountServer)
+	configServer := &config.ServerConfig{}
+	protos.RegisterConfigServiceServer(s, configServer)
+	userServer := &user.ServerUser{}
+	protos.RegisterUserServiceServer(s, userServer)
 	protos.RegisterVersionServiceServer(s, &version.ServerVersion{})
 	protos.RegisterAppServiceServer(s, &app.ServerApp{})
 
-	logrus.Infof("gRPC server listening on %d", GConfig.BindPort)
-	portString := fmt.Sprintf(":%d", GConfig.BindPort)
-	listener, err := net.Listen("tcp", portString)
+	// 注册适配器扩展服务
+	adapterProtos.RegisterAccountExtServiceServer(s, &account.ServerAccountExt{ServerAccount: accountServer})
+	adapterProtos.RegisterUserExtServiceServer(s, userServer)
+	adapterProtos.RegisterConfigExtServiceServer(s, configServer)
+	adapterProtos.RegisterReservationServiceServer(s, &reservation.ServerReservation{})
+	adapterProtos.RegisterMaintenanceServiceServer(s, &maintenance.ServerMaintenance{})
+
+	// 注册健康检查服务，除版本服务外的服务都依赖CraneCtld，状态跟随适配器的就绪状态
+	grpc_health_v1.RegisterHealthServer(s, monitor.HealthServer)
+	var services []string
+	for service := range s.GetServiceInfo() {
+		switch service {
+		case grpc_health_v1.Health_ServiceDesc.ServiceName:
+		case protos.VersionService_ServiceDesc.ServiceName:
+			monitor.HealthServer.SetServingStatus(service, grpc_health_v1.HealthCheckResponse_SERVING)
+		default:
+			services = append(services, service)
+		}
+	}
+	monitor.InitHealth(services, GConfig.Health)
+	monitor.StartHealthProbe(ctx, GConfig.Health)
+
+	listeners, err := listenAdapter(GConfig)
 	if err != nil {
 		logrus.Fatalf("failed to listen: %s", err)
 		return
 	}
 
-	if err := s.Serve(listener); err != nil {
-		logrus.Fatalf("gRPC server quitting: %s", err)
+	serveErr := make(chan error, len(listeners))
+	for _, listener := range listeners {
+		logrus.Infof("gRPC server listening on %v", listener.Addr())
+		go func(listener net.Listener) {
+			serveErr <- s.Serve(listener)
+		}(listener)
+	}
+
+	// 收到SIGHUP或配置文件被修改时重新加载配置
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	configChanged := watchConfigFile()
+
+	for {
+		select {
+		case err := <-serveErr:
+			logrus.Fatalf("gRPC server quitting: %s", err)
+		case <-hup:
+			reloadConfig(true)
+		case <-configChanged:
+			reloadConfig(false)
+		case <-ctx.Done():
+			shutdown(s, metricsServer)
+			return
+		}
+	}
+}
+
+// shutdown 不再接受新的请求，等待正在处理的请求完成，超过shutdown-timeout后强制停止，然后关闭指标服务和各连接
+func shutdown(s *grpc.Server, metricsServer *http.Server) {
+	timeout := defaultShutdownTimeout
+	if GConfig.ShutdownTimeout > 0 {
+		timeout = time.Duration(GConfig.ShutdownTimeout) * time.Second
+	}
+	logrus.Infof("shutting down, waiting up to %v for in-flight requests", timeout)
+	monitor.ShutdownHealth()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		logrus.Warnf("in-flight requests not finished after %v, stopping gRPC server", timeout)
+		s.Stop()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), metricsShutdownTimeout)
+	defer cancel()
+	if err := metricsServer.Shutdown(ctx); err != nil {
+		logrus.Warnf("shutdown metrics server failed: %s", err)
 	}
+	utils.CloseClients(ctx)
+	logrus.Infof("adapter stopped")
 }
@@ -0,0 +1,93 @@
+package app
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"scow-crane-adapter/pkg/utils"
+)
+
+const (
+	defaultUnixSocketMode = 0660
+	// unixSocketDialTimeout 检查已有的socket文件是否仍有进程在监听时连接的超时
+	unixSocketDialTimeout = time.Second
+)
+
+// listenAddress 拼接监听地址，host为空时监听所有地址，IPv6地址可以带或不带方括号
+func listenAddress(host string, port int) string {
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// listenAdapter 监听bind-addr和bind-port，配置了unix-socket时同时监听Unix domain socket
+func listenAdapter(config utils.Config) ([]net.Listener, error) {
+	listener, err := net.Listen("tcp", listenAddress(config.BindAddr, config.BindPort))
+	if err != nil {
+		return nil, err
+	}
+	listeners := []net.Listener{listener}
+
+	if config.UnixSocket.Path != "" {
+		unixListener, err := listenUnixSocket(config.UnixSocket)
+		if err != nil {
+			listener.Close()
+			return nil, err
+		}
+		listeners = append(listeners, unixListener)
+	}
+	return listeners, nil
+}
+
+// unixSocketMode 解析八进制的socket文件权限，为空时为defaultUnixSocketMode
+func unixSocketMode(mode string) (os.FileMode, error) {
+	if mode == "" {
+		return defaultUnixSocketMode, nil
+	}
+	parsed, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid unix-socket mode %v: %v", mode, err)
+	}
+	if parsed > 0777 {
+		return 0, fmt.Errorf("invalid unix-socket mode %v: only permission bits are allowed", mode)
+	}
+	return os.FileMode(parsed), nil
+}
+
+// listenUnixSocket 监听Unix domain socket，适配器上次未正常退出留下的socket文件先删除
+// 已有的socket文件仍有进程在监听时返回错误，避免抢占另一个适配器的socket
+func listenUnixSocket(config utils.UnixSocketConfig) (net.Listener, error) {
+	mode, err := unixSocketMode(config.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	if info, err := os.Lstat(config.Path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("unix-socket path %v exists and is not a socket", config.Path)
+		}
+		if conn, err := net.DialTimeout("unix", config.Path, unixSocketDialTimeout); err == nil {
+			conn.Close()
+			return nil, fmt.Errorf("unix-socket path %v is in use by another process", config.Path)
+		}
+		logrus.Infof("removing stale unix socket %v", config.Path)
+		if err = os.Remove(config.Path); err != nil {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen("unix", config.Path)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.Chmod(config.Path, mode); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
@@ -0,0 +1,100 @@
+package app
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"scow-crane-adapter/pkg/utils"
+)
+
+func TestListenAddress(t *testing.T) {
+	tests := []struct {
+		host     string
+		port     int
+		expected string
+	}{
+		{"", 8972, ":8972"},
+		{"0.0.0.0", 8972, "0.0.0.0:8972"},
+		{"127.0.0.1", 80, "127.0.0.1:80"},
+		{"::", 8972, "[::]:8972"},
+		{"[::]", 8972, "[::]:8972"},
+		{"fe80::1", 8972, "[fe80::1]:8972"},
+		{"[fe80::1]", 8972, "[fe80::1]:8972"},
+		{"localhost", 8972, "localhost:8972"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, listenAddress(tt.host, tt.port), tt.host)
+	}
+}
+
+func TestUnixSocketMode(t *testing.T) {
+	tests := []struct {
+		mode     string
+		expected os.FileMode
+		wantErr  bool
+	}{
+		{"", 0660, false},
+		{"600", 0600, false},
+		{"0666", 0666, false},
+		{"777", 0777, false},
+		{"888", 0, true},
+		{"rw-rw----", 0, true},
+		{"4755", 0, true},
+	}
+	for _, tt := range tests {
+		mode, err := unixSocketMode(tt.mode)
+		if tt.wantErr {
+			assert.Error(t, err, tt.mode)
+			continue
+		}
+		require.NoError(t, err, tt.mode)
+		assert.Equal(t, tt.expected, mode, tt.mode)
+	}
+}
+
+// socketDir socket路径长度有限制，不使用t.TempDir中较长的路径
+func socketDir(t *testing.T) string {
+	dir, err := os.MkdirTemp("", "adapter")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func TestListenUnixSocket(t *testing.T) {
+	path := filepath.Join(socketDir(t), "adapter.sock")
+
+	listener, err := listenUnixSocket(utils.UnixSocketConfig{Path: path, Mode: "600"})
+	require.NoError(t, err)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// 仍有进程在监听时不删除socket文件
+	_, err = listenUnixSocket(utils.UnixSocketConfig{Path: path})
+	assert.ErrorContains(t, err, "in use")
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	conn.Close()
+
+	// 上次未正常退出留下的socket文件被删除后重新监听
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	listener.Close()
+	_, err = os.Lstat(path)
+	require.NoError(t, err)
+	listener, err = listenUnixSocket(utils.UnixSocketConfig{Path: path})
+	require.NoError(t, err)
+	listener.Close()
+}
+
+func TestListenUnixSocketNotSocket(t *testing.T) {
+	path := filepath.Join(socketDir(t), "adapter.sock")
+	require.NoError(t, os.WriteFile(path, nil, 0600))
+
+	_, err := listenUnixSocket(utils.UnixSocketConfig{Path: path})
+	assert.ErrorContains(t, err, "not a socket")
+}
@@ -0,0 +1,112 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"scow-crane-adapter/pkg/utils"
+)
+
+const defaultImportActionsPerSecond = 10
+
+func newExportCommand() *cobra.Command {
+	var (
+		output string
+		format string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export accounts, users and their associations to a JSON or YAML document",
+		Long: "Export accounts, users and their associations to a JSON or YAML document.\n" +
+			"The adapter must be stopped first, the state dir can only be used by one process at a time.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// 未指定格式时根据输出文件的扩展名判断，默认为json
+			if format == "" {
+				format = "json"
+				if ext := strings.TrimPrefix(filepath.Ext(output), "."); ext == "yaml" || ext == "yml" {
+					format = ext
+				}
+			}
+
+			initAdapter()
+			document, err := utils.ExportAccountUsers(cmd.Context())
+			if err != nil {
+				return err
+			}
+			content, err := utils.MarshalMigrationDocument(document, format)
+			if err != nil {
+				return err
+			}
+
+			if output == "" {
+				_, err = os.Stdout.Write(content)
+				return err
+			}
+			if err = os.WriteFile(output, content, 0600); err != nil {
+				return err
+			}
+			logrus.Infof("exported %v accounts to %v", len(document.Accounts), output)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file, defaults to stdout")
+	cmd.Flags().StringVarP(&format, "format", "f", "", "Output format, json or yaml")
+	return cmd
+}
+
+func newImportCommand() *cobra.Command {
+	var (
+		dryRun           bool
+		actionsPerSecond int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import accounts, users and their associations from a document created by export",
+		Long: "Import accounts, users and their associations from a document created by export.\n" +
+			"Only missing accounts, users, partitions and qos are added, nothing is deleted, so importing the same document again is a no-op.\n" +
+			"The adapter must be stopped first, the state dir can only be used by one process at a time.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			document, err := utils.LoadMigrationDocument(args[0])
+			if err != nil {
+				return err
+			}
+
+			initAdapter()
+			actions, err := utils.PlanImport(cmd.Context(), document)
+			if err != nil {
+				return err
+			}
+
+			// 先输出计划，便于确认将要执行的修改
+			for _, action := range actions {
+				fmt.Println(action.Description)
+			}
+			fmt.Printf("Plan: %d changes.\n", len(actions))
+			if dryRun || len(actions) == 0 {
+				return nil
+			}
+
+			applied := 0
+			err = utils.ApplyImport(cmd.Context(), actions, actionsPerSecond, func(action *utils.MigrationAction) {
+				applied++
+				logrus.Infof("[%d/%d] %v", applied, len(actions), action.Description)
+			})
+			if err != nil {
+				return fmt.Errorf("import stopped after %d/%d changes: %v", applied, len(actions), err)
+			}
+			fmt.Printf("Applied %d changes.\n", applied)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only print the plan without applying it")
+	cmd.Flags().IntVar(&actionsPerSecond, "actions-per-second", defaultImportActionsPerSecond, "Maximum changes applied per second, a change may make several CraneCtld calls")
+	return cmd
+}
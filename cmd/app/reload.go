@@ -0,0 +1,217 @@
+package app
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/credentials"
+
+	"scow-crane-adapter/pkg/utils"
+)
+
+// configReloadDelay 配置文件最后一次变化后等待的时间，保存文件时会先截断再分多次写入，避免读到空文件或写了一半的文件
+const configReloadDelay = time.Second
+
+// loadedConfigContent 上次加载的配置文件内容，文件监听触发的重新加载在内容未变化时跳过
+var loadedConfigContent []byte
+
+// serverTlsConfig 适配器的证书和CA，重新加载时整体替换，之后建立的连接使用新的证书
+var serverTlsConfig atomic.Pointer[tls.Config]
+
+// loadServerTlsConfig 读取GetCertPath返回的证书、私钥和CA
+func loadServerTlsConfig() (*tls.Config, error) {
+	caCertPath, adapterCertPath, adapterPrivateKeyPath := GetCertPath()
+	logrus.Tracef("caCertPath, adapterCertPath, adapterPrivateKeyPath: %s, %s, %s", caCertPath, adapterCertPath, adapterPrivateKeyPath)
+	pair, err := tls.LoadX509KeyPair(adapterCertPath, adapterPrivateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("LoadX509KeyPair error: %v", err)
+	}
+	// 创建一组根证书
+	certPool := x509.NewCertPool()
+	ca, err := os.ReadFile(caCertPath)
+	if err != nil {
+		return nil, fmt.Errorf("read ca pem error: %v", err)
+	}
+	// 解析证书
+	if ok := certPool.AppendCertsFromPEM(ca); !ok {
+		return nil, fmt.Errorf("AppendCertsFromPEM error")
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{pair},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    certPool,
+		NextProtos:   []string{"h2"},
+	}, nil
+}
+
+// serverCredentials 每个新连接握手时使用当前的serverTlsConfig
+func serverCredentials() credentials.TransportCredentials {
+	return credentials.NewTLS(&tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return serverTlsConfig.Load(), nil
+		},
+	})
+}
+
+// watchConfigFile 配置文件被修改时发出通知，未使用配置文件时不监听
+func watchConfigFile() <-chan struct{} {
+	changed := make(chan struct{}, 1)
+	configFile := viper.ConfigFileUsed()
+	if configFile == "" {
+		return changed
+	}
+	configFile, _ = filepath.Abs(configFile)
+	loadedConfigContent, _ = os.ReadFile(configFile)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		logrus.Warnf("watch config file failed, reload with SIGHUP instead: %s", err)
+		return changed
+	}
+	// 监听所在目录，编辑器保存和配置管理工具替换文件时文件本身会被删除重建
+	if err = watcher.Add(filepath.Dir(configFile)); err != nil {
+		logrus.Warnf("watch config file failed, reload with SIGHUP instead: %s", err)
+		watcher.Close()
+		return changed
+	}
+	go func() {
+		// 保存一次文件会产生多个事件，最后一个事件之后configReloadDelay内没有新的事件才通知
+		debounce := time.NewTimer(configReloadDelay)
+		debounce.Stop()
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != configFile || !event.Has(fsnotify.Write|fsnotify.Create) {
+					continue
+				}
+				debounce.Reset(configReloadDelay)
+			case <-debounce.C:
+				select {
+				case changed <- struct{}{}:
+				default:
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logrus.Warnf("watch config file error: %s", err)
+			}
+		}
+	}()
+	return changed
+}
+
+// reloadConfig 重新读取配置文件和证书，signaled为true表示由SIGHUP触发，否则由配置文件的修改触发
+// 日志级别、证书和CA、分区配置、执行命令的超时、取消作业的等待时间、节点特性、shutdown-timeout以及授权策略立即生效，其他配置的修改需要重启适配器
+// 配置文件为空时不重新加载；关闭授权需要重启适配器，重新加载时保持当前的授权策略
+func reloadConfig(signaled bool) {
+	content, err := os.ReadFile(viper.ConfigFileUsed())
+	if err != nil {
+		logrus.Errorf("reload config failed, keep the current config: %s", err)
+		return
+	}
+	if len(bytes.TrimSpace(content)) == 0 {
+		logrus.Errorf("reload config failed, config file %v is empty, keep the current config", viper.ConfigFileUsed())
+		return
+	}
+	// SIGHUP时即使配置文件未变化也重新加载，证书和授权策略文件可能已经变化
+	if !signaled && bytes.Equal(content, loadedConfigContent) {
+		logrus.Debugf("config file not changed, skip reloading")
+		return
+	}
+
+	logrus.Infof("reloading config")
+	if err = viper.ReadConfig(bytes.NewReader(content)); err != nil {
+		logrus.Errorf("reload config failed, keep the current config: %s", err)
+		return
+	}
+	var newConfig utils.Config
+	if err = viper.Unmarshal(&newConfig); err != nil {
+		logrus.Errorf("reload config failed, keep the current config: %s", err)
+		return
+	}
+	loadedConfigContent = content
+
+	for _, field := range restartRequiredChanges(GConfig, newConfig) {
+		logrus.Warnf("config %v changed, restart the adapter to apply it", field)
+	}
+
+	if newConfig.LogLevel != GConfig.LogLevel {
+		logrus.SetLevel(utils.ParseLogLevel(newConfig.LogLevel))
+		GConfig.LogLevel = newConfig.LogLevel
+	}
+	if newConfig.Partition != GConfig.Partition {
+		utils.InitPartitionDiscovery(newConfig.Partition)
+		GConfig.Partition = newConfig.Partition
+	}
+	utils.SetCommandRunnerConfig(newConfig.CommandRunner)
+	GConfig.CommandRunner = newConfig.CommandRunner
+	utils.SetJobCancelConfig(newConfig.JobCancel)
+	GConfig.JobCancel = newConfig.JobCancel
+	utils.SetNodeFeatures(newConfig.NodeFeatures)
+	GConfig.NodeFeatures = newConfig.NodeFeatures
+	GConfig.ShutdownTimeout = newConfig.ShutdownTimeout
+	if GConfig.Authorization.Enabled && !newConfig.Authorization.Enabled {
+		logrus.Warnf("authorization disabled in config file, keep enforcing the current policy until the adapter restarts")
+	} else if err = utils.InitAuthorization(newConfig.Authorization); err != nil {
+		logrus.Errorf("reload authorization policy failed, keep the current policy: %s", err)
+	} else {
+		GConfig.Authorization = newConfig.Authorization
+	}
+
+	if GConfig.Ssl.Enabled {
+		GConfig.Ssl.CaCertPath = newConfig.Ssl.CaCertPath
+		GConfig.Ssl.AdapterCertPath = newConfig.Ssl.AdapterCertPath
+		GConfig.Ssl.AdapterPrivateKeyPath = newConfig.Ssl.AdapterPrivateKeyPath
+		tlsConfig, err := loadServerTlsConfig()
+		if err != nil {
+			logrus.Errorf("reload certificate failed, keep the current certificate: %s", err)
+		} else {
+			serverTlsConfig.Store(tlsConfig)
+		}
+	}
+	logrus.Infof("config reloaded")
+}
+
+// restartRequiredChanges 返回修改后需要重启适配器才能生效的配置项
+func restartRequiredChanges(current, next utils.Config) []string {
+	fields := []struct {
+		name          string
+		current, next interface{}
+	}{
+		{"bind-addr", current.BindAddr, next.BindAddr},
+		{"bind-port", current.BindPort, next.BindPort},
+		{"unix-socket", current.UnixSocket, next.UnixSocket},
+		{"state-dir", current.StateDir, next.StateDir},
+		{"ssl.enabled", current.Ssl.Enabled, next.Ssl.Enabled},
+		{"monitor", current.Monitor, next.Monitor},
+		{"identity", current.Identity, next.Identity},
+		{"crane-tls", current.CraneTls, next.CraneTls},
+		{"crane-client", current.CraneClient, next.CraneClient},
+		{"health", current.Health, next.Health},
+		// 开启授权立即生效，关闭授权需要重启，避免误改配置文件使所有客户端都可以调用所有方法
+		{"authorization.enabled", current.Authorization.Enabled, current.Authorization.Enabled && next.Authorization.Enabled},
+	}
+
+	var changed []string
+	for _, field := range fields {
+		if !reflect.DeepEqual(field.current, field.next) {
+			changed = append(changed, field.name)
+		}
+	}
+	return changed
+}
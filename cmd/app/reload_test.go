@@ -0,0 +1,89 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scow-crane-adapter/pkg/utils"
+)
+
+const testPolicy = `
+clients:
+  - name: dashboard
+    tokens: ["dashboard-token"]
+    roles: [read-only]
+`
+
+// useTestConfigFile 在临时目录中写入策略文件，返回写入配置文件的函数，测试结束后恢复全局配置
+func useTestConfigFile(t *testing.T) func(content string) {
+	dir := t.TempDir()
+	policyFile := filepath.Join(dir, "authorization.yaml")
+	require.NoError(t, os.WriteFile(policyFile, []byte(testPolicy), 0600))
+	configFile := filepath.Join(dir, "config.yaml")
+
+	previousConfig, previousContent := GConfig, loadedConfigContent
+	viper.Reset()
+	viper.SetConfigFile(configFile)
+	t.Cleanup(func() {
+		GConfig, loadedConfigContent = previousConfig, previousContent
+		viper.Reset()
+		require.NoError(t, utils.InitAuthorization(utils.AuthorizationConfig{}))
+	})
+
+	return func(content string) {
+		require.NoError(t, os.WriteFile(configFile, []byte(fmt.Sprintf(content, policyFile)), 0600))
+	}
+}
+
+// assertAuthorizationEnforced 未识别的客户端调用写方法时被拒绝
+func assertAuthorizationEnforced(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/scow.scheduler_adapter.AccountService/CreateAccount"}
+	_, err := utils.AuthorizationUnaryInterceptor()(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestReloadConfigKeepsAuthorization(t *testing.T) {
+	writeConfig := useTestConfigFile(t)
+	enabled := "log-level: info\nauthorization:\n  enabled: true\n  policy-file: %v\n"
+
+	writeConfig(enabled)
+	reloadConfig(true)
+	require.True(t, GConfig.Authorization.Enabled)
+	assertAuthorizationEnforced(t)
+
+	// 保存文件时被截断的空文件
+	writeConfig("")
+	reloadConfig(false)
+	assert.True(t, GConfig.Authorization.Enabled)
+	assertAuthorizationEnforced(t)
+
+	// 写了一半的文件
+	writeConfig("log-level: info\nauthorization:\n  enabled: [\n")
+	reloadConfig(false)
+	assertAuthorizationEnforced(t)
+
+	// 策略文件读取失败
+	writeConfig("authorization:\n  enabled: true\n  policy-file: %v.missing\n")
+	reloadConfig(true)
+	assertAuthorizationEnforced(t)
+
+	// 关闭授权需要重启，SIGHUP也不生效
+	writeConfig("log-level: info\n# %v\n")
+	reloadConfig(true)
+	assert.True(t, GConfig.Authorization.Enabled)
+	assertAuthorizationEnforced(t)
+	assert.Equal(t, []string{"authorization.enabled"}, restartRequiredChanges(GConfig, utils.Config{}))
+	// 开启授权立即生效
+	assert.Empty(t, restartRequiredChanges(utils.Config{}, GConfig))
+}
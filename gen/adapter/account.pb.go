@@ -0,0 +1,1958 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: adapter/account.proto
+
+package adapter
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LimitField int32
+
+const (
+	LimitField_LIMIT_FIELD_UNSPECIFIED LimitField = 0
+	LimitField_MAX_JOBS                LimitField = 1
+	LimitField_MAX_CPUS                LimitField = 2
+	LimitField_MAX_TIME_LIMIT_SECONDS  LimitField = 3
+	LimitField_MAX_SUBMIT_JOBS         LimitField = 4
+)
+
+// Enum value maps for LimitField.
+var (
+	LimitField_name = map[int32]string{
+		0: "LIMIT_FIELD_UNSPECIFIED",
+		1: "MAX_JOBS",
+		2: "MAX_CPUS",
+		3: "MAX_TIME_LIMIT_SECONDS",
+		4: "MAX_SUBMIT_JOBS",
+	}
+	LimitField_value = map[string]int32{
+		"LIMIT_FIELD_UNSPECIFIED": 0,
+		"MAX_JOBS":                1,
+		"MAX_CPUS":                2,
+		"MAX_TIME_LIMIT_SECONDS":  3,
+		"MAX_SUBMIT_JOBS":         4,
+	}
+)
+
+func (x LimitField) Enum() *LimitField {
+	p := new(LimitField)
+	*p = x
+	return p
+}
+
+func (x LimitField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LimitField) Descriptor() protoreflect.EnumDescriptor {
+	return file_adapter_account_proto_enumTypes[0].Descriptor()
+}
+
+func (LimitField) Type() protoreflect.EnumType {
+	return &file_adapter_account_proto_enumTypes[0]
+}
+
+func (x LimitField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LimitField.Descriptor instead.
+func (LimitField) EnumDescriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{0}
+}
+
+type GrantAccountPartitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Partitions    []string               `protobuf:"bytes,2,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantAccountPartitionsRequest) Reset() {
+	*x = GrantAccountPartitionsRequest{}
+	mi := &file_adapter_account_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantAccountPartitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantAccountPartitionsRequest) ProtoMessage() {}
+
+func (x *GrantAccountPartitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantAccountPartitionsRequest.ProtoReflect.Descriptor instead.
+func (*GrantAccountPartitionsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GrantAccountPartitionsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *GrantAccountPartitionsRequest) GetPartitions() []string {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+type GrantAccountPartitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantAccountPartitionsResponse) Reset() {
+	*x = GrantAccountPartitionsResponse{}
+	mi := &file_adapter_account_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantAccountPartitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantAccountPartitionsResponse) ProtoMessage() {}
+
+func (x *GrantAccountPartitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantAccountPartitionsResponse.ProtoReflect.Descriptor instead.
+func (*GrantAccountPartitionsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{1}
+}
+
+type RevokeAccountPartitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Partitions    []string               `protobuf:"bytes,2,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAccountPartitionsRequest) Reset() {
+	*x = RevokeAccountPartitionsRequest{}
+	mi := &file_adapter_account_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAccountPartitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccountPartitionsRequest) ProtoMessage() {}
+
+func (x *RevokeAccountPartitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccountPartitionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAccountPartitionsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RevokeAccountPartitionsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *RevokeAccountPartitionsRequest) GetPartitions() []string {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+type RevokeAccountPartitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAccountPartitionsResponse) Reset() {
+	*x = RevokeAccountPartitionsResponse{}
+	mi := &file_adapter_account_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAccountPartitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccountPartitionsResponse) ProtoMessage() {}
+
+func (x *RevokeAccountPartitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccountPartitionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAccountPartitionsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{3}
+}
+
+type SetAccountQosRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountName    string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	AllowedQosList []string               `protobuf:"bytes,2,rep,name=allowed_qos_list,json=allowedQosList,proto3" json:"allowed_qos_list,omitempty"`
+	// 为空时取allowed_qos_list中的第一个
+	DefaultQos    *string `protobuf:"bytes,3,opt,name=default_qos,json=defaultQos,proto3,oneof" json:"default_qos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountQosRequest) Reset() {
+	*x = SetAccountQosRequest{}
+	mi := &file_adapter_account_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountQosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountQosRequest) ProtoMessage() {}
+
+func (x *SetAccountQosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountQosRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountQosRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetAccountQosRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *SetAccountQosRequest) GetAllowedQosList() []string {
+	if x != nil {
+		return x.AllowedQosList
+	}
+	return nil
+}
+
+func (x *SetAccountQosRequest) GetDefaultQos() string {
+	if x != nil && x.DefaultQos != nil {
+		return *x.DefaultQos
+	}
+	return ""
+}
+
+type SetAccountQosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountQosResponse) Reset() {
+	*x = SetAccountQosResponse{}
+	mi := &file_adapter_account_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountQosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountQosResponse) ProtoMessage() {}
+
+func (x *SetAccountQosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountQosResponse.ProtoReflect.Descriptor instead.
+func (*SetAccountQosResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{5}
+}
+
+type GetAccountPartitionQosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountPartitionQosRequest) Reset() {
+	*x = GetAccountPartitionQosRequest{}
+	mi := &file_adapter_account_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountPartitionQosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountPartitionQosRequest) ProtoMessage() {}
+
+func (x *GetAccountPartitionQosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountPartitionQosRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountPartitionQosRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAccountPartitionQosRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type GetAccountPartitionQosResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AccountName       string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	GrantedPartitions []string               `protobuf:"bytes,2,rep,name=granted_partitions,json=grantedPartitions,proto3" json:"granted_partitions,omitempty"`
+	BlockedPartitions []string               `protobuf:"bytes,3,rep,name=blocked_partitions,json=blockedPartitions,proto3" json:"blocked_partitions,omitempty"`
+	AllowedQosList    []string               `protobuf:"bytes,4,rep,name=allowed_qos_list,json=allowedQosList,proto3" json:"allowed_qos_list,omitempty"`
+	DefaultQos        string                 `protobuf:"bytes,5,opt,name=default_qos,json=defaultQos,proto3" json:"default_qos,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetAccountPartitionQosResponse) Reset() {
+	*x = GetAccountPartitionQosResponse{}
+	mi := &file_adapter_account_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountPartitionQosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountPartitionQosResponse) ProtoMessage() {}
+
+func (x *GetAccountPartitionQosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountPartitionQosResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountPartitionQosResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAccountPartitionQosResponse) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *GetAccountPartitionQosResponse) GetGrantedPartitions() []string {
+	if x != nil {
+		return x.GrantedPartitions
+	}
+	return nil
+}
+
+func (x *GetAccountPartitionQosResponse) GetBlockedPartitions() []string {
+	if x != nil {
+		return x.BlockedPartitions
+	}
+	return nil
+}
+
+func (x *GetAccountPartitionQosResponse) GetAllowedQosList() []string {
+	if x != nil {
+		return x.AllowedQosList
+	}
+	return nil
+}
+
+func (x *GetAccountPartitionQosResponse) GetDefaultQos() string {
+	if x != nil {
+		return x.DefaultQos
+	}
+	return ""
+}
+
+// 资源限制，对应鹤思ModifyField中的MaxJobsPerUser、MaxCpusPerUser、MaxTimeLimitPerTask
+// 字段不存在表示不限制(或不修改)
+// 鹤思没有限制提交作业数的字段，设置max_submit_jobs时返回UNIMPLEMENTED
+type ResourceLimits struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MaxJobs             *uint32                `protobuf:"varint,1,opt,name=max_jobs,json=maxJobs,proto3,oneof" json:"max_jobs,omitempty"`
+	MaxCpus             *uint32                `protobuf:"varint,2,opt,name=max_cpus,json=maxCpus,proto3,oneof" json:"max_cpus,omitempty"`
+	MaxTimeLimitSeconds *uint64                `protobuf:"varint,3,opt,name=max_time_limit_seconds,json=maxTimeLimitSeconds,proto3,oneof" json:"max_time_limit_seconds,omitempty"`
+	MaxSubmitJobs       *uint32                `protobuf:"varint,4,opt,name=max_submit_jobs,json=maxSubmitJobs,proto3,oneof" json:"max_submit_jobs,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ResourceLimits) Reset() {
+	*x = ResourceLimits{}
+	mi := &file_adapter_account_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceLimits) ProtoMessage() {}
+
+func (x *ResourceLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceLimits.ProtoReflect.Descriptor instead.
+func (*ResourceLimits) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResourceLimits) GetMaxJobs() uint32 {
+	if x != nil && x.MaxJobs != nil {
+		return *x.MaxJobs
+	}
+	return 0
+}
+
+func (x *ResourceLimits) GetMaxCpus() uint32 {
+	if x != nil && x.MaxCpus != nil {
+		return *x.MaxCpus
+	}
+	return 0
+}
+
+func (x *ResourceLimits) GetMaxTimeLimitSeconds() uint64 {
+	if x != nil && x.MaxTimeLimitSeconds != nil {
+		return *x.MaxTimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *ResourceLimits) GetMaxSubmitJobs() uint32 {
+	if x != nil && x.MaxSubmitJobs != nil {
+		return *x.MaxSubmitJobs
+	}
+	return 0
+}
+
+type SetAccountLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Limits        *ResourceLimits        `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountLimitsRequest) Reset() {
+	*x = SetAccountLimitsRequest{}
+	mi := &file_adapter_account_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountLimitsRequest) ProtoMessage() {}
+
+func (x *SetAccountLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetAccountLimitsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *SetAccountLimitsRequest) GetLimits() *ResourceLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type SetAccountLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountLimitsResponse) Reset() {
+	*x = SetAccountLimitsResponse{}
+	mi := &file_adapter_account_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountLimitsResponse) ProtoMessage() {}
+
+func (x *SetAccountLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountLimitsResponse.ProtoReflect.Descriptor instead.
+func (*SetAccountLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{10}
+}
+
+type ClearAccountLimitsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccountName string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// 为空时清除所有资源限制
+	Fields        []LimitField `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=scow.crane_adapter.LimitField" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearAccountLimitsRequest) Reset() {
+	*x = ClearAccountLimitsRequest{}
+	mi := &file_adapter_account_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearAccountLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAccountLimitsRequest) ProtoMessage() {}
+
+func (x *ClearAccountLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAccountLimitsRequest.ProtoReflect.Descriptor instead.
+func (*ClearAccountLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ClearAccountLimitsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ClearAccountLimitsRequest) GetFields() []LimitField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ClearAccountLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearAccountLimitsResponse) Reset() {
+	*x = ClearAccountLimitsResponse{}
+	mi := &file_adapter_account_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearAccountLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAccountLimitsResponse) ProtoMessage() {}
+
+func (x *ClearAccountLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAccountLimitsResponse.ProtoReflect.Descriptor instead.
+func (*ClearAccountLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{12}
+}
+
+type GetAccountLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountLimitsRequest) Reset() {
+	*x = GetAccountLimitsRequest{}
+	mi := &file_adapter_account_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountLimitsRequest) ProtoMessage() {}
+
+func (x *GetAccountLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetAccountLimitsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type GetAccountLimitsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通过SetAccountLimits设置的限制
+	Limits *ResourceLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+	// 与qos上限合并后实际生效的限制
+	EffectiveLimits *ResourceLimits `protobuf:"bytes,2,opt,name=effective_limits,json=effectiveLimits,proto3" json:"effective_limits,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetAccountLimitsResponse) Reset() {
+	*x = GetAccountLimitsResponse{}
+	mi := &file_adapter_account_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountLimitsResponse) ProtoMessage() {}
+
+func (x *GetAccountLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAccountLimitsResponse) GetLimits() *ResourceLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *GetAccountLimitsResponse) GetEffectiveLimits() *ResourceLimits {
+	if x != nil {
+		return x.EffectiveLimits
+	}
+	return nil
+}
+
+type SetUserLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountName   string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Limits        *ResourceLimits        `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserLimitsRequest) Reset() {
+	*x = SetUserLimitsRequest{}
+	mi := &file_adapter_account_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserLimitsRequest) ProtoMessage() {}
+
+func (x *SetUserLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetUserLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetUserLimitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserLimitsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *SetUserLimitsRequest) GetLimits() *ResourceLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type SetUserLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserLimitsResponse) Reset() {
+	*x = SetUserLimitsResponse{}
+	mi := &file_adapter_account_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserLimitsResponse) ProtoMessage() {}
+
+func (x *SetUserLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserLimitsResponse.ProtoReflect.Descriptor instead.
+func (*SetUserLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{16}
+}
+
+type ClearUserLimitsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountName string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// 为空时清除所有资源限制
+	Fields        []LimitField `protobuf:"varint,3,rep,packed,name=fields,proto3,enum=scow.crane_adapter.LimitField" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearUserLimitsRequest) Reset() {
+	*x = ClearUserLimitsRequest{}
+	mi := &file_adapter_account_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearUserLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearUserLimitsRequest) ProtoMessage() {}
+
+func (x *ClearUserLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearUserLimitsRequest.ProtoReflect.Descriptor instead.
+func (*ClearUserLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ClearUserLimitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClearUserLimitsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ClearUserLimitsRequest) GetFields() []LimitField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ClearUserLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearUserLimitsResponse) Reset() {
+	*x = ClearUserLimitsResponse{}
+	mi := &file_adapter_account_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearUserLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearUserLimitsResponse) ProtoMessage() {}
+
+func (x *ClearUserLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearUserLimitsResponse.ProtoReflect.Descriptor instead.
+func (*ClearUserLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{18}
+}
+
+type GetUserLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountName   string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLimitsRequest) Reset() {
+	*x = GetUserLimitsRequest{}
+	mi := &file_adapter_account_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLimitsRequest) ProtoMessage() {}
+
+func (x *GetUserLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUserLimitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserLimitsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type GetUserLimitsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Limits          *ResourceLimits        `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+	EffectiveLimits *ResourceLimits        `protobuf:"bytes,2,opt,name=effective_limits,json=effectiveLimits,proto3" json:"effective_limits,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetUserLimitsResponse) Reset() {
+	*x = GetUserLimitsResponse{}
+	mi := &file_adapter_account_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLimitsResponse) ProtoMessage() {}
+
+func (x *GetUserLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserLimitsResponse) GetLimits() *ResourceLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *GetUserLimitsResponse) GetEffectiveLimits() *ResourceLimits {
+	if x != nil {
+		return x.EffectiveLimits
+	}
+	return nil
+}
+
+type ListEffectiveLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNames  []string               `protobuf:"bytes,1,rep,name=account_names,json=accountNames,proto3" json:"account_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEffectiveLimitsRequest) Reset() {
+	*x = ListEffectiveLimitsRequest{}
+	mi := &file_adapter_account_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEffectiveLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEffectiveLimitsRequest) ProtoMessage() {}
+
+func (x *ListEffectiveLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEffectiveLimitsRequest.ProtoReflect.Descriptor instead.
+func (*ListEffectiveLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListEffectiveLimitsRequest) GetAccountNames() []string {
+	if x != nil {
+		return x.AccountNames
+	}
+	return nil
+}
+
+type ListEffectiveLimitsResponse struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	Accounts      []*ListEffectiveLimitsResponse_AccountLimits `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEffectiveLimitsResponse) Reset() {
+	*x = ListEffectiveLimitsResponse{}
+	mi := &file_adapter_account_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEffectiveLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEffectiveLimitsResponse) ProtoMessage() {}
+
+func (x *ListEffectiveLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEffectiveLimitsResponse.ProtoReflect.Descriptor instead.
+func (*ListEffectiveLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListEffectiveLimitsResponse) GetAccounts() []*ListEffectiveLimitsResponse_AccountLimits {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccountName string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	OwnerUserId string                 `protobuf:"bytes,2,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	// 为空时创建为顶层账户
+	ParentAccount string `protobuf:"bytes,3,opt,name=parent_account,json=parentAccount,proto3" json:"parent_account,omitempty"`
+	Description   string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_adapter_account_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateAccountRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetOwnerUserId() string {
+	if x != nil {
+		return x.OwnerUserId
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetParentAccount() string {
+	if x != nil {
+		return x.ParentAccount
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_adapter_account_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{24}
+}
+
+type ListAccountTreeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 为空时列出所有顶层账户及其子账户
+	RootAccount   *string `protobuf:"bytes,1,opt,name=root_account,json=rootAccount,proto3,oneof" json:"root_account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountTreeRequest) Reset() {
+	*x = ListAccountTreeRequest{}
+	mi := &file_adapter_account_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountTreeRequest) ProtoMessage() {}
+
+func (x *ListAccountTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountTreeRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountTreeRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListAccountTreeRequest) GetRootAccount() string {
+	if x != nil && x.RootAccount != nil {
+		return *x.RootAccount
+	}
+	return ""
+}
+
+type AccountTreeNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Blocked       bool                   `protobuf:"varint,3,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	Children      []*AccountTreeNode     `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountTreeNode) Reset() {
+	*x = AccountTreeNode{}
+	mi := &file_adapter_account_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountTreeNode) ProtoMessage() {}
+
+func (x *AccountTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountTreeNode.ProtoReflect.Descriptor instead.
+func (*AccountTreeNode) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AccountTreeNode) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *AccountTreeNode) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AccountTreeNode) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *AccountTreeNode) GetChildren() []*AccountTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type ListAccountTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roots         []*AccountTreeNode     `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountTreeResponse) Reset() {
+	*x = ListAccountTreeResponse{}
+	mi := &file_adapter_account_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountTreeResponse) ProtoMessage() {}
+
+func (x *ListAccountTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountTreeResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountTreeResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListAccountTreeResponse) GetRoots() []*AccountTreeNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+type DeleteAccountWithOptionsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccountName string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// 删除前取消账户下未结束的作业，取消前会先封锁账户，彻底删除失败时解封本次封锁的账户
+	CancelJobs bool `protobuf:"varint,2,opt,name=cancel_jobs,json=cancelJobs,proto3" json:"cancel_jobs,omitempty"`
+	// 只封锁账户并归档账户信息，不从鹤思中删除，之后再次调用且soft_delete为false时彻底删除
+	SoftDelete bool `protobuf:"varint,3,opt,name=soft_delete,json=softDelete,proto3" json:"soft_delete,omitempty"`
+	// 取消作业后等待作业结束的最长时间(秒)，为0时使用配置文件中job-cancel的设置
+	CancelJobsWaitTimeout uint32 `protobuf:"varint,4,opt,name=cancel_jobs_wait_timeout,json=cancelJobsWaitTimeout,proto3" json:"cancel_jobs_wait_timeout,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DeleteAccountWithOptionsRequest) Reset() {
+	*x = DeleteAccountWithOptionsRequest{}
+	mi := &file_adapter_account_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountWithOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountWithOptionsRequest) ProtoMessage() {}
+
+func (x *DeleteAccountWithOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountWithOptionsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountWithOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteAccountWithOptionsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *DeleteAccountWithOptionsRequest) GetCancelJobs() bool {
+	if x != nil {
+		return x.CancelJobs
+	}
+	return false
+}
+
+func (x *DeleteAccountWithOptionsRequest) GetSoftDelete() bool {
+	if x != nil {
+		return x.SoftDelete
+	}
+	return false
+}
+
+func (x *DeleteAccountWithOptionsRequest) GetCancelJobsWaitTimeout() uint32 {
+	if x != nil {
+		return x.CancelJobsWaitTimeout
+	}
+	return 0
+}
+
+type DeleteAccountWithOptionsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CancelledJobIds []uint32               `protobuf:"varint,1,rep,packed,name=cancelled_job_ids,json=cancelledJobIds,proto3" json:"cancelled_job_ids,omitempty"`
+	// 为true时账户只被封锁并归档，仍存在于鹤思中
+	SoftDeleted   bool `protobuf:"varint,2,opt,name=soft_deleted,json=softDeleted,proto3" json:"soft_deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountWithOptionsResponse) Reset() {
+	*x = DeleteAccountWithOptionsResponse{}
+	mi := &file_adapter_account_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountWithOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountWithOptionsResponse) ProtoMessage() {}
+
+func (x *DeleteAccountWithOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountWithOptionsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountWithOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteAccountWithOptionsResponse) GetCancelledJobIds() []uint32 {
+	if x != nil {
+		return x.CancelledJobIds
+	}
+	return nil
+}
+
+func (x *DeleteAccountWithOptionsResponse) GetSoftDeleted() bool {
+	if x != nil {
+		return x.SoftDeleted
+	}
+	return false
+}
+
+type ListEffectiveLimitsResponse_UserLimits struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EffectiveLimits *ResourceLimits        `protobuf:"bytes,2,opt,name=effective_limits,json=effectiveLimits,proto3" json:"effective_limits,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListEffectiveLimitsResponse_UserLimits) Reset() {
+	*x = ListEffectiveLimitsResponse_UserLimits{}
+	mi := &file_adapter_account_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEffectiveLimitsResponse_UserLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEffectiveLimitsResponse_UserLimits) ProtoMessage() {}
+
+func (x *ListEffectiveLimitsResponse_UserLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEffectiveLimitsResponse_UserLimits.ProtoReflect.Descriptor instead.
+func (*ListEffectiveLimitsResponse_UserLimits) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *ListEffectiveLimitsResponse_UserLimits) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListEffectiveLimitsResponse_UserLimits) GetEffectiveLimits() *ResourceLimits {
+	if x != nil {
+		return x.EffectiveLimits
+	}
+	return nil
+}
+
+type ListEffectiveLimitsResponse_AccountLimits struct {
+	state           protoimpl.MessageState                    `protogen:"open.v1"`
+	AccountName     string                                    `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	EffectiveLimits *ResourceLimits                           `protobuf:"bytes,2,opt,name=effective_limits,json=effectiveLimits,proto3" json:"effective_limits,omitempty"`
+	Users           []*ListEffectiveLimitsResponse_UserLimits `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListEffectiveLimitsResponse_AccountLimits) Reset() {
+	*x = ListEffectiveLimitsResponse_AccountLimits{}
+	mi := &file_adapter_account_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEffectiveLimitsResponse_AccountLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEffectiveLimitsResponse_AccountLimits) ProtoMessage() {}
+
+func (x *ListEffectiveLimitsResponse_AccountLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_account_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEffectiveLimitsResponse_AccountLimits.ProtoReflect.Descriptor instead.
+func (*ListEffectiveLimitsResponse_AccountLimits) Descriptor() ([]byte, []int) {
+	return file_adapter_account_proto_rawDescGZIP(), []int{22, 1}
+}
+
+func (x *ListEffectiveLimitsResponse_AccountLimits) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListEffectiveLimitsResponse_AccountLimits) GetEffectiveLimits() *ResourceLimits {
+	if x != nil {
+		return x.EffectiveLimits
+	}
+	return nil
+}
+
+func (x *ListEffectiveLimitsResponse_AccountLimits) GetUsers() []*ListEffectiveLimitsResponse_UserLimits {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+var File_adapter_account_proto protoreflect.FileDescriptor
+
+const file_adapter_account_proto_rawDesc = "" +
+	"\n" +
+	"\x15adapter/account.proto\x12\x12scow.crane_adapter\"b\n" +
+	"\x1dGrantAccountPartitionsRequest\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12\x1e\n" +
+	"\n" +
+	"partitions\x18\x02 \x03(\tR\n" +
+	"partitions\" \n" +
+	"\x1eGrantAccountPartitionsResponse\"c\n" +
+	"\x1eRevokeAccountPartitionsRequest\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12\x1e\n" +
+	"\n" +
+	"partitions\x18\x02 \x03(\tR\n" +
+	"partitions\"!\n" +
+	"\x1fRevokeAccountPartitionsResponse\"\x99\x01\n" +
+	"\x14SetAccountQosRequest\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12(\n" +
+	"\x10allowed_qos_list\x18\x02 \x03(\tR\x0eallowedQosList\x12$\n" +
+	"\vdefault_qos\x18\x03 \x01(\tH\x00R\n" +
+	"defaultQos\x88\x01\x01B\x0e\n" +
+	"\f_default_qos\"\x17\n" +
+	"\x15SetAccountQosResponse\"B\n" +
+	"\x1dGetAccountPartitionQosRequest\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\"\xec\x01\n" +
+	"\x1eGetAccountPartitionQosResponse\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12-\n" +
+	"\x12granted_partitions\x18\x02 \x03(\tR\x11grantedPartitions\x12-\n" +
+	"\x12blocked_partitions\x18\x03 \x03(\tR\x11blockedPartitions\x12(\n" +
+	"\x10allowed_qos_list\x18\x04 \x03(\tR\x0eallowedQosList\x12\x1f\n" +
+	"\vdefault_qos\x18\x05 \x01(\tR\n" +
+	"defaultQos\"\x80\x02\n" +
+	"\x0eResourceLimits\x12\x1e\n" +
+	"\bmax_jobs\x18\x01 \x01(\rH\x00R\amaxJobs\x88\x01\x01\x12\x1e\n" +
+	"\bmax_cpus\x18\x02 \x01(\rH\x01R\amaxCpus\x88\x01\x01\x128\n" +
+	"\x16max_time_limit_seconds\x18\x03 \x01(\x04H\x02R\x13maxTimeLimitSeconds\x88\x01\x01\x12+\n" +
+	"\x0fmax_submit_jobs\x18\x04 \x01(\rH\x03R\rmaxSubmitJobs\x88\x01\x01B\v\n" +
+	"\t_max_jobsB\v\n" +
+	"\t_max_cpusB\x19\n" +
+	"\x17_max_time_limit_secondsB\x12\n" +
+	"\x10_max_submit_jobs\"x\n" +
+	"\x17SetAccountLimitsRequest\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12:\n" +
+	"\x06limits\x18\x02 \x01(\v2\".scow.crane_adapter.ResourceLimitsR\x06limits\"\x1a\n" +
+	"\x18SetAccountLimitsResponse\"v\n" +
+	"\x19ClearAccountLimitsRequest\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x126\n" +
+	"\x06fields\x18\x02 \x03(\x0e2\x1e.scow.crane_adapter.LimitFieldR\x06fields\"\x1c\n" +
+	"\x1aClearAccountLimitsResponse\"<\n" +
+	"\x17GetAccountLimitsRequest\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\"\xa5\x01\n" +
+	"\x18GetAccountLimitsResponse\x12:\n" +
+	"\x06limits\x18\x01 \x01(\v2\".scow.crane_adapter.ResourceLimitsR\x06limits\x12M\n" +
+	"\x10effective_limits\x18\x02 \x01(\v2\".scow.crane_adapter.ResourceLimitsR\x0feffectiveLimits\"\x8e\x01\n" +
+	"\x14SetUserLimitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12:\n" +
+	"\x06limits\x18\x03 \x01(\v2\".scow.crane_adapter.ResourceLimitsR\x06limits\"\x17\n" +
+	"\x15SetUserLimitsResponse\"\x8c\x01\n" +
+	"\x16ClearUserLimitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x126\n" +
+	"\x06fields\x18\x03 \x03(\x0e2\x1e.scow.crane_adapter.LimitFieldR\x06fields\"\x19\n" +
+	"\x17ClearUserLimitsResponse\"R\n" +
+	"\x14GetUserLimitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\"\xa2\x01\n" +
+	"\x15GetUserLimitsResponse\x12:\n" +
+	"\x06limits\x18\x01 \x01(\v2\".scow.crane_adapter.ResourceLimitsR\x06limits\x12M\n" +
+	"\x10effective_limits\x18\x02 \x01(\v2\".scow.crane_adapter.ResourceLimitsR\x0feffectiveLimits\"A\n" +
+	"\x1aListEffectiveLimitsRequest\x12#\n" +
+	"\raccount_names\x18\x01 \x03(\tR\faccountNames\"\xc4\x03\n" +
+	"\x1bListEffectiveLimitsResponse\x12Y\n" +
+	"\baccounts\x18\x01 \x03(\v2=.scow.crane_adapter.ListEffectiveLimitsResponse.AccountLimitsR\baccounts\x1at\n" +
+	"\n" +
+	"UserLimits\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12M\n" +
+	"\x10effective_limits\x18\x02 \x01(\v2\".scow.crane_adapter.ResourceLimitsR\x0feffectiveLimits\x1a\xd3\x01\n" +
+	"\rAccountLimits\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12M\n" +
+	"\x10effective_limits\x18\x02 \x01(\v2\".scow.crane_adapter.ResourceLimitsR\x0feffectiveLimits\x12P\n" +
+	"\x05users\x18\x03 \x03(\v2:.scow.crane_adapter.ListEffectiveLimitsResponse.UserLimitsR\x05users\"\xa6\x01\n" +
+	"\x14CreateAccountRequest\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12\"\n" +
+	"\rowner_user_id\x18\x02 \x01(\tR\vownerUserId\x12%\n" +
+	"\x0eparent_account\x18\x03 \x01(\tR\rparentAccount\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"\x17\n" +
+	"\x15CreateAccountResponse\"Q\n" +
+	"\x16ListAccountTreeRequest\x12&\n" +
+	"\froot_account\x18\x01 \x01(\tH\x00R\vrootAccount\x88\x01\x01B\x0f\n" +
+	"\r_root_account\"\xb1\x01\n" +
+	"\x0fAccountTreeNode\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\ablocked\x18\x03 \x01(\bR\ablocked\x12?\n" +
+	"\bchildren\x18\x04 \x03(\v2#.scow.crane_adapter.AccountTreeNodeR\bchildren\"T\n" +
+	"\x17ListAccountTreeResponse\x129\n" +
+	"\x05roots\x18\x01 \x03(\v2#.scow.crane_adapter.AccountTreeNodeR\x05roots\"\xbf\x01\n" +
+	"\x1fDeleteAccountWithOptionsRequest\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12\x1f\n" +
+	"\vcancel_jobs\x18\x02 \x01(\bR\n" +
+	"cancelJobs\x12\x1f\n" +
+	"\vsoft_delete\x18\x03 \x01(\bR\n" +
+	"softDelete\x127\n" +
+	"\x18cancel_jobs_wait_timeout\x18\x04 \x01(\rR\x15cancelJobsWaitTimeout\"q\n" +
+	" DeleteAccountWithOptionsResponse\x12*\n" +
+	"\x11cancelled_job_ids\x18\x01 \x03(\rR\x0fcancelledJobIds\x12!\n" +
+	"\fsoft_deleted\x18\x02 \x01(\bR\vsoftDeleted*v\n" +
+	"\n" +
+	"LimitField\x12\x1b\n" +
+	"\x17LIMIT_FIELD_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bMAX_JOBS\x10\x01\x12\f\n" +
+	"\bMAX_CPUS\x10\x02\x12\x1a\n" +
+	"\x16MAX_TIME_LIMIT_SECONDS\x10\x03\x12\x13\n" +
+	"\x0fMAX_SUBMIT_JOBS\x10\x042\xdd\f\n" +
+	"\x11AccountExtService\x12\x7f\n" +
+	"\x16GrantAccountPartitions\x121.scow.crane_adapter.GrantAccountPartitionsRequest\x1a2.scow.crane_adapter.GrantAccountPartitionsResponse\x12\x82\x01\n" +
+	"\x17RevokeAccountPartitions\x122.scow.crane_adapter.RevokeAccountPartitionsRequest\x1a3.scow.crane_adapter.RevokeAccountPartitionsResponse\x12d\n" +
+	"\rSetAccountQos\x12(.scow.crane_adapter.SetAccountQosRequest\x1a).scow.crane_adapter.SetAccountQosResponse\x12\x7f\n" +
+	"\x16GetAccountPartitionQos\x121.scow.crane_adapter.GetAccountPartitionQosRequest\x1a2.scow.crane_adapter.GetAccountPartitionQosResponse\x12m\n" +
+	"\x10SetAccountLimits\x12+.scow.crane_adapter.SetAccountLimitsRequest\x1a,.scow.crane_adapter.SetAccountLimitsResponse\x12s\n" +
+	"\x12ClearAccountLimits\x12-.scow.crane_adapter.ClearAccountLimitsRequest\x1a..scow.crane_adapter.ClearAccountLimitsResponse\x12m\n" +
+	"\x10GetAccountLimits\x12+.scow.crane_adapter.GetAccountLimitsRequest\x1a,.scow.crane_adapter.GetAccountLimitsResponse\x12d\n" +
+	"\rSetUserLimits\x12(.scow.crane_adapter.SetUserLimitsRequest\x1a).scow.crane_adapter.SetUserLimitsResponse\x12j\n" +
+	"\x0fClearUserLimits\x12*.scow.crane_adapter.ClearUserLimitsRequest\x1a+.scow.crane_adapter.ClearUserLimitsResponse\x12d\n" +
+	"\rGetUserLimits\x12(.scow.crane_adapter.GetUserLimitsRequest\x1a).scow.crane_adapter.GetUserLimitsResponse\x12v\n" +
+	"\x13ListEffectiveLimits\x12..scow.crane_adapter.ListEffectiveLimitsRequest\x1a/.scow.crane_adapter.ListEffectiveLimitsResponse\x12d\n" +
+	"\rCreateAccount\x12(.scow.crane_adapter.CreateAccountRequest\x1a).scow.crane_adapter.CreateAccountResponse\x12j\n" +
+	"\x0fListAccountTree\x12*.scow.crane_adapter.ListAccountTreeRequest\x1a+.scow.crane_adapter.ListAccountTreeResponse\x12\x85\x01\n" +
+	"\x18DeleteAccountWithOptions\x123.scow.crane_adapter.DeleteAccountWithOptionsRequest\x1a4.scow.crane_adapter.DeleteAccountWithOptionsResponseB Z\x1escow-crane-adapter/gen/adapterb\x06proto3"
+
+var (
+	file_adapter_account_proto_rawDescOnce sync.Once
+	file_adapter_account_proto_rawDescData []byte
+)
+
+func file_adapter_account_proto_rawDescGZIP() []byte {
+	file_adapter_account_proto_rawDescOnce.Do(func() {
+		file_adapter_account_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_adapter_account_proto_rawDesc), len(file_adapter_account_proto_rawDesc)))
+	})
+	return file_adapter_account_proto_rawDescData
+}
+
+var file_adapter_account_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_adapter_account_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_adapter_account_proto_goTypes = []any{
+	(LimitField)(0),                                   // 0: scow.crane_adapter.LimitField
+	(*GrantAccountPartitionsRequest)(nil),             // 1: scow.crane_adapter.GrantAccountPartitionsRequest
+	(*GrantAccountPartitionsResponse)(nil),            // 2: scow.crane_adapter.GrantAccountPartitionsResponse
+	(*RevokeAccountPartitionsRequest)(nil),            // 3: scow.crane_adapter.RevokeAccountPartitionsRequest
+	(*RevokeAccountPartitionsResponse)(nil),           // 4: scow.crane_adapter.RevokeAccountPartitionsResponse
+	(*SetAccountQosRequest)(nil),                      // 5: scow.crane_adapter.SetAccountQosRequest
+	(*SetAccountQosResponse)(nil),                     // 6: scow.crane_adapter.SetAccountQosResponse
+	(*GetAccountPartitionQosRequest)(nil),             // 7: scow.crane_adapter.GetAccountPartitionQosRequest
+	(*GetAccountPartitionQosResponse)(nil),            // 8: scow.crane_adapter.GetAccountPartitionQosResponse
+	(*ResourceLimits)(nil),                            // 9: scow.crane_adapter.ResourceLimits
+	(*SetAccountLimitsRequest)(nil),                   // 10: scow.crane_adapter.SetAccountLimitsRequest
+	(*SetAccountLimitsResponse)(nil),                  // 11: scow.crane_adapter.SetAccountLimitsResponse
+	(*ClearAccountLimitsRequest)(nil),                 // 12: scow.crane_adapter.ClearAccountLimitsRequest
+	(*ClearAccountLimitsResponse)(nil),                // 13: scow.crane_adapter.ClearAccountLimitsResponse
+	(*GetAccountLimitsRequest)(nil),                   // 14: scow.crane_adapter.GetAccountLimitsRequest
+	(*GetAccountLimitsResponse)(nil),                  // 15: scow.crane_adapter.GetAccountLimitsResponse
+	(*SetUserLimitsRequest)(nil),                      // 16: scow.crane_adapter.SetUserLimitsRequest
+	(*SetUserLimitsResponse)(nil),                     // 17: scow.crane_adapter.SetUserLimitsResponse
+	(*ClearUserLimitsRequest)(nil),                    // 18: scow.crane_adapter.ClearUserLimitsRequest
+	(*ClearUserLimitsResponse)(nil),                   // 19: scow.crane_adapter.ClearUserLimitsResponse
+	(*GetUserLimitsRequest)(nil),                      // 20: scow.crane_adapter.GetUserLimitsRequest
+	(*GetUserLimitsResponse)(nil),                     // 21: scow.crane_adapter.GetUserLimitsResponse
+	(*ListEffectiveLimitsRequest)(nil),                // 22: scow.crane_adapter.ListEffectiveLimitsRequest
+	(*ListEffectiveLimitsResponse)(nil),               // 23: scow.crane_adapter.ListEffectiveLimitsResponse
+	(*CreateAccountRequest)(nil),                      // 24: scow.crane_adapter.CreateAccountRequest
+	(*CreateAccountResponse)(nil),                     // 25: scow.crane_adapter.CreateAccountResponse
+	(*ListAccountTreeRequest)(nil),                    // 26: scow.crane_adapter.ListAccountTreeRequest
+	(*AccountTreeNode)(nil),                           // 27: scow.crane_adapter.AccountTreeNode
+	(*ListAccountTreeResponse)(nil),                   // 28: scow.crane_adapter.ListAccountTreeResponse
+	(*DeleteAccountWithOptionsRequest)(nil),           // 29: scow.crane_adapter.DeleteAccountWithOptionsRequest
+	(*DeleteAccountWithOptionsResponse)(nil),          // 30: scow.crane_adapter.DeleteAccountWithOptionsResponse
+	(*ListEffectiveLimitsResponse_UserLimits)(nil),    // 31: scow.crane_adapter.ListEffectiveLimitsResponse.UserLimits
+	(*ListEffectiveLimitsResponse_AccountLimits)(nil), // 32: scow.crane_adapter.ListEffectiveLimitsResponse.AccountLimits
+}
+var file_adapter_account_proto_depIdxs = []int32{
+	9,  // 0: scow.crane_adapter.SetAccountLimitsRequest.limits:type_name -> scow.crane_adapter.ResourceLimits
+	0,  // 1: scow.crane_adapter.ClearAccountLimitsRequest.fields:type_name -> scow.crane_adapter.LimitField
+	9,  // 2: scow.crane_adapter.GetAccountLimitsResponse.limits:type_name -> scow.crane_adapter.ResourceLimits
+	9,  // 3: scow.crane_adapter.GetAccountLimitsResponse.effective_limits:type_name -> scow.crane_adapter.ResourceLimits
+	9,  // 4: scow.crane_adapter.SetUserLimitsRequest.limits:type_name -> scow.crane_adapter.ResourceLimits
+	0,  // 5: scow.crane_adapter.ClearUserLimitsRequest.fields:type_name -> scow.crane_adapter.LimitField
+	9,  // 6: scow.crane_adapter.GetUserLimitsResponse.limits:type_name -> scow.crane_adapter.ResourceLimits
+	9,  // 7: scow.crane_adapter.GetUserLimitsResponse.effective_limits:type_name -> scow.crane_adapter.ResourceLimits
+	32, // 8: scow.crane_adapter.ListEffectiveLimitsResponse.accounts:type_name -> scow.crane_adapter.ListEffectiveLimitsResponse.AccountLimits
+	27, // 9: scow.crane_adapter.AccountTreeNode.children:type_name -> scow.crane_adapter.AccountTreeNode
+	27, // 10: scow.crane_adapter.ListAccountTreeResponse.roots:type_name -> scow.crane_adapter.AccountTreeNode
+	9,  // 11: scow.crane_adapter.ListEffectiveLimitsResponse.UserLimits.effective_limits:type_name -> scow.crane_adapter.ResourceLimits
+	9,  // 12: scow.crane_adapter.ListEffectiveLimitsResponse.AccountLimits.effective_limits:type_name -> scow.crane_adapter.ResourceLimits
+	31, // 13: scow.crane_adapter.ListEffectiveLimitsResponse.AccountLimits.users:type_name -> scow.crane_adapter.ListEffectiveLimitsResponse.UserLimits
+	1,  // 14: scow.crane_adapter.AccountExtService.GrantAccountPartitions:input_type -> scow.crane_adapter.GrantAccountPartitionsRequest
+	3,  // 15: scow.crane_adapter.AccountExtService.RevokeAccountPartitions:input_type -> scow.crane_adapter.RevokeAccountPartitionsRequest
+	5,  // 16: scow.crane_adapter.AccountExtService.SetAccountQos:input_type -> scow.crane_adapter.SetAccountQosRequest
+	7,  // 17: scow.crane_adapter.AccountExtService.GetAccountPartitionQos:input_type -> scow.crane_adapter.GetAccountPartitionQosRequest
+	10, // 18: scow.crane_adapter.AccountExtService.SetAccountLimits:input_type -> scow.crane_adapter.SetAccountLimitsRequest
+	12, // 19: scow.crane_adapter.AccountExtService.ClearAccountLimits:input_type -> scow.crane_adapter.ClearAccountLimitsRequest
+	14, // 20: scow.crane_adapter.AccountExtService.GetAccountLimits:input_type -> scow.crane_adapter.GetAccountLimitsRequest
+	16, // 21: scow.crane_adapter.AccountExtService.SetUserLimits:input_type -> scow.crane_adapter.SetUserLimitsRequest
+	18, // 22: scow.crane_adapter.AccountExtService.ClearUserLimits:input_type -> scow.crane_adapter.ClearUserLimitsRequest
+	20, // 23: scow.crane_adapter.AccountExtService.GetUserLimits:input_type -> scow.crane_adapter.GetUserLimitsRequest
+	22, // 24: scow.crane_adapter.AccountExtService.ListEffectiveLimits:input_type -> scow.crane_adapter.ListEffectiveLimitsRequest
+	24, // 25: scow.crane_adapter.AccountExtService.CreateAccount:input_type -> scow.crane_adapter.CreateAccountRequest
+	26, // 26: scow.crane_adapter.AccountExtService.ListAccountTree:input_type -> scow.crane_adapter.ListAccountTreeRequest
+	29, // 27: scow.crane_adapter.AccountExtService.DeleteAccountWithOptions:input_type -> scow.crane_adapter.DeleteAccountWithOptionsRequest
+	2,  // 28: scow.crane_adapter.AccountExtService.GrantAccountPartitions:output_type -> scow.crane_adapter.GrantAccountPartitionsResponse
+	4,  // 29: scow.crane_adapter.AccountExtService.RevokeAccountPartitions:output_type -> scow.crane_adapter.RevokeAccountPartitionsResponse
+	6,  // 30: scow.crane_adapter.AccountExtService.SetAccountQos:output_type -> scow.crane_adapter.SetAccountQosResponse
+	8,  // 31: scow.crane_adapter.AccountExtService.GetAccountPartitionQos:output_type -> scow.crane_adapter.GetAccountPartitionQosResponse
+	11, // 32: scow.crane_adapter.AccountExtService.SetAccountLimits:output_type -> scow.crane_adapter.SetAccountLimitsResponse
+	13, // 33: scow.crane_adapter.AccountExtService.ClearAccountLimits:output_type -> scow.crane_adapter.ClearAccountLimitsResponse
+	15, // 34: scow.crane_adapter.AccountExtService.GetAccountLimits:output_type -> scow.crane_adapter.GetAccountLimitsResponse
+	17, // 35: scow.crane_adapter.AccountExtService.SetUserLimits:output_type -> scow.crane_adapter.SetUserLimitsResponse
+	19, // 36: scow.crane_adapter.AccountExtService.ClearUserLimits:output_type -> scow.crane_adapter.ClearUserLimitsResponse
+	21, // 37: scow.crane_adapter.AccountExtService.GetUserLimits:output_type -> scow.crane_adapter.GetUserLimitsResponse
+	23, // 38: scow.crane_adapter.AccountExtService.ListEffectiveLimits:output_type -> scow.crane_adapter.ListEffectiveLimitsResponse
+	25, // 39: scow.crane_adapter.AccountExtService.CreateAccount:output_type -> scow.crane_adapter.CreateAccountResponse
+	28, // 40: scow.crane_adapter.AccountExtService.ListAccountTree:output_type -> scow.crane_adapter.ListAccountTreeResponse
+	30, // 41: scow.crane_adapter.AccountExtService.DeleteAccountWithOptions:output_type -> scow.crane_adapter.DeleteAccountWithOptionsResponse
+	28, // [28:42] is the sub-list for method output_type
+	14, // [14:28] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_adapter_account_proto_init() }
+func file_adapter_account_proto_init() {
+	if File_adapter_account_proto != nil {
+		return
+	}
+	file_adapter_account_proto_msgTypes[4].OneofWrappers = []any{}
+	file_adapter_account_proto_msgTypes[8].OneofWrappers = []any{}
+	file_adapter_account_proto_msgTypes[25].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_adapter_account_proto_rawDesc), len(file_adapter_account_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   32,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_adapter_account_proto_goTypes,
+		DependencyIndexes: file_adapter_account_proto_depIdxs,
+		EnumInfos:         file_adapter_account_proto_enumTypes,
+		MessageInfos:      file_adapter_account_proto_msgTypes,
+	}.Build()
+	File_adapter_account_proto = out.File
+	file_adapter_account_proto_goTypes = nil
+	file_adapter_account_proto_depIdxs = nil
+}
@@ -0,0 +1,651 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: adapter/account.proto
+
+package adapter
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AccountExtService_GrantAccountPartitions_FullMethodName   = "/scow.crane_adapter.AccountExtService/GrantAccountPartitions"
+	AccountExtService_RevokeAccountPartitions_FullMethodName  = "/scow.crane_adapter.AccountExtService/RevokeAccountPartitions"
+	AccountExtService_SetAccountQos_FullMethodName            = "/scow.crane_adapter.AccountExtService/SetAccountQos"
+	AccountExtService_GetAccountPartitionQos_FullMethodName   = "/scow.crane_adapter.AccountExtService/GetAccountPartitionQos"
+	AccountExtService_SetAccountLimits_FullMethodName         = "/scow.crane_adapter.AccountExtService/SetAccountLimits"
+	AccountExtService_ClearAccountLimits_FullMethodName       = "/scow.crane_adapter.AccountExtService/ClearAccountLimits"
+	AccountExtService_GetAccountLimits_FullMethodName         = "/scow.crane_adapter.AccountExtService/GetAccountLimits"
+	AccountExtService_SetUserLimits_FullMethodName            = "/scow.crane_adapter.AccountExtService/SetUserLimits"
+	AccountExtService_ClearUserLimits_FullMethodName          = "/scow.crane_adapter.AccountExtService/ClearUserLimits"
+	AccountExtService_GetUserLimits_FullMethodName            = "/scow.crane_adapter.AccountExtService/GetUserLimits"
+	AccountExtService_ListEffectiveLimits_FullMethodName      = "/scow.crane_adapter.AccountExtService/ListEffectiveLimits"
+	AccountExtService_CreateAccount_FullMethodName            = "/scow.crane_adapter.AccountExtService/CreateAccount"
+	AccountExtService_ListAccountTree_FullMethodName          = "/scow.crane_adapter.AccountExtService/ListAccountTree"
+	AccountExtService_DeleteAccountWithOptions_FullMethodName = "/scow.crane_adapter.AccountExtService/DeleteAccountWithOptions"
+)
+
+// AccountExtServiceClient is the client API for AccountExtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 适配器在scow调度器接口之外提供的账户扩展接口
+type AccountExtServiceClient interface {
+	// 给账户授予分区，账户下已有用户同步获得该分区
+	GrantAccountPartitions(ctx context.Context, in *GrantAccountPartitionsRequest, opts ...grpc.CallOption) (*GrantAccountPartitionsResponse, error)
+	// 收回账户的分区，与封锁不同，收回后解封不会恢复该分区
+	RevokeAccountPartitions(ctx context.Context, in *RevokeAccountPartitionsRequest, opts ...grpc.CallOption) (*RevokeAccountPartitionsResponse, error)
+	// 设置账户允许使用的qos列表以及默认qos，并下发至账户下的用户
+	SetAccountQos(ctx context.Context, in *SetAccountQosRequest, opts ...grpc.CallOption) (*SetAccountQosResponse, error)
+	// 查询账户被授予、被封锁的分区以及qos设置
+	GetAccountPartitionQos(ctx context.Context, in *GetAccountPartitionQosRequest, opts ...grpc.CallOption) (*GetAccountPartitionQosResponse, error)
+	// 设置账户的资源限制，未设置的字段保持不变，不能超过账户可用qos的上限
+	SetAccountLimits(ctx context.Context, in *SetAccountLimitsRequest, opts ...grpc.CallOption) (*SetAccountLimitsResponse, error)
+	// 清除账户的资源限制，清除后回到qos的上限
+	ClearAccountLimits(ctx context.Context, in *ClearAccountLimitsRequest, opts ...grpc.CallOption) (*ClearAccountLimitsResponse, error)
+	// 查询账户设置的资源限制以及生效的资源限制
+	GetAccountLimits(ctx context.Context, in *GetAccountLimitsRequest, opts ...grpc.CallOption) (*GetAccountLimitsResponse, error)
+	// 设置用户在账户下的资源限制，不能超过账户的资源限制和qos的上限
+	SetUserLimits(ctx context.Context, in *SetUserLimitsRequest, opts ...grpc.CallOption) (*SetUserLimitsResponse, error)
+	// 清除用户在账户下的资源限制
+	ClearUserLimits(ctx context.Context, in *ClearUserLimitsRequest, opts ...grpc.CallOption) (*ClearUserLimitsResponse, error)
+	// 查询用户在账户下设置的资源限制以及生效的资源限制
+	GetUserLimits(ctx context.Context, in *GetUserLimitsRequest, opts ...grpc.CallOption) (*GetUserLimitsResponse, error)
+	// 批量查询账户及其用户生效的资源限制，account_names为空时查询所有未归档的账户
+	ListEffectiveLimits(ctx context.Context, in *ListEffectiveLimitsRequest, opts ...grpc.CallOption) (*ListEffectiveLimitsResponse, error)
+	// 创建账户并将用户添加至账户中，可以指定父账户和描述，父账户不存在时会先创建父账户
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	// 以树的形式列出账户的父子关系
+	ListAccountTree(ctx context.Context, in *ListAccountTreeRequest, opts ...grpc.CallOption) (*ListAccountTreeResponse, error)
+	// 删除账户，可选择先取消账户下未结束的作业，或者只封锁账户并归档账户信息(软删除)
+	// 存在未结束的作业时返回FAILED_PRECONDITION，错误详情的job_ids中列出这些作业
+	// 已归档的账户不能解封或添加用户，也不会出现在账户列表中，对其再次调用且soft_delete为false时彻底删除
+	// 部分用户移出账户失败时账户不会被删除，返回ABORTED，错误详情的removed_users和failed_users中列出已移除和移除失败的用户
+	DeleteAccountWithOptions(ctx context.Context, in *DeleteAccountWithOptionsRequest, opts ...grpc.CallOption) (*DeleteAccountWithOptionsResponse, error)
+}
+
+type accountExtServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountExtServiceClient(cc grpc.ClientConnInterface) AccountExtServiceClient {
+	return &accountExtServiceClient{cc}
+}
+
+func (c *accountExtServiceClient) GrantAccountPartitions(ctx context.Context, in *GrantAccountPartitionsRequest, opts ...grpc.CallOption) (*GrantAccountPartitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantAccountPartitionsResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_GrantAccountPartitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountExtServiceClient) RevokeAccountPartitions(ctx context.Context, in *RevokeAccountPartitionsRequest, opts ...grpc.CallOption) (*RevokeAccountPartitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAccountPartitionsResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_RevokeAccountPartitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountExtServiceClient) SetAccountQos(ctx context.Context, in *SetAccountQosRequest, opts ...grpc.CallOption) (*SetAccountQosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAccountQosResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_SetAccountQos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountExtServiceClient) GetAccountPartitionQos(ctx context.Context, in *GetAccountPartitionQosRequest, opts ...grpc.CallOption) (*GetAccountPartitionQosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountPartitionQosResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_GetAccountPartitionQos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountExtServiceClient) SetAccountLimits(ctx context.Context, in *SetAccountLimitsRequest, opts ...grpc.CallOption) (*SetAccountLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAccountLimitsResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_SetAccountLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountExtServiceClient) ClearAccountLimits(ctx context.Context, in *ClearAccountLimitsRequest, opts ...grpc.CallOption) (*ClearAccountLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearAccountLimitsResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_ClearAccountLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountExtServiceClient) GetAccountLimits(ctx context.Context, in *GetAccountLimitsRequest, opts ...grpc.CallOption) (*GetAccountLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountLimitsResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_GetAccountLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountExtServiceClient) SetUserLimits(ctx context.Context, in *SetUserLimitsRequest, opts ...grpc.CallOption) (*SetUserLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserLimitsResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_SetUserLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountExtServiceClient) ClearUserLimits(ctx context.Context, in *ClearUserLimitsRequest, opts ...grpc.CallOption) (*ClearUserLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearUserLimitsResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_ClearUserLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountExtServiceClient) GetUserLimits(ctx context.Context, in *GetUserLimitsRequest, opts ...grpc.CallOption) (*GetUserLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserLimitsResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_GetUserLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountExtServiceClient) ListEffectiveLimits(ctx context.Context, in *ListEffectiveLimitsRequest, opts ...grpc.CallOption) (*ListEffectiveLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEffectiveLimitsResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_ListEffectiveLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountExtServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_CreateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountExtServiceClient) ListAccountTree(ctx context.Context, in *ListAccountTreeRequest, opts ...grpc.CallOption) (*ListAccountTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountTreeResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_ListAccountTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountExtServiceClient) DeleteAccountWithOptions(ctx context.Context, in *DeleteAccountWithOptionsRequest, opts ...grpc.CallOption) (*DeleteAccountWithOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccountWithOptionsResponse)
+	err := c.cc.Invoke(ctx, AccountExtService_DeleteAccountWithOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountExtServiceServer is the server API for AccountExtService service.
+// All implementations should embed UnimplementedAccountExtServiceServer
+// for forward compatibility.
+//
+// 适配器在scow调度器接口之外提供的账户扩展接口
+type AccountExtServiceServer interface {
+	// 给账户授予分区，账户下已有用户同步获得该分区
+	GrantAccountPartitions(context.Context, *GrantAccountPartitionsRequest) (*GrantAccountPartitionsResponse, error)
+	// 收回账户的分区，与封锁不同，收回后解封不会恢复该分区
+	RevokeAccountPartitions(context.Context, *RevokeAccountPartitionsRequest) (*RevokeAccountPartitionsResponse, error)
+	// 设置账户允许使用的qos列表以及默认qos，并下发至账户下的用户
+	SetAccountQos(context.Context, *SetAccountQosRequest) (*SetAccountQosResponse, error)
+	// 查询账户被授予、被封锁的分区以及qos设置
+	GetAccountPartitionQos(context.Context, *GetAccountPartitionQosRequest) (*GetAccountPartitionQosResponse, error)
+	// 设置账户的资源限制，未设置的字段保持不变，不能超过账户可用qos的上限
+	SetAccountLimits(context.Context, *SetAccountLimitsRequest) (*SetAccountLimitsResponse, error)
+	// 清除账户的资源限制，清除后回到qos的上限
+	ClearAccountLimits(context.Context, *ClearAccountLimitsRequest) (*ClearAccountLimitsResponse, error)
+	// 查询账户设置的资源限制以及生效的资源限制
+	GetAccountLimits(context.Context, *GetAccountLimitsRequest) (*GetAccountLimitsResponse, error)
+	// 设置用户在账户下的资源限制，不能超过账户的资源限制和qos的上限
+	SetUserLimits(context.Context, *SetUserLimitsRequest) (*SetUserLimitsResponse, error)
+	// 清除用户在账户下的资源限制
+	ClearUserLimits(context.Context, *ClearUserLimitsRequest) (*ClearUserLimitsResponse, error)
+	// 查询用户在账户下设置的资源限制以及生效的资源限制
+	GetUserLimits(context.Context, *GetUserLimitsRequest) (*GetUserLimitsResponse, error)
+	// 批量查询账户及其用户生效的资源限制，account_names为空时查询所有未归档的账户
+	ListEffectiveLimits(context.Context, *ListEffectiveLimitsRequest) (*ListEffectiveLimitsResponse, error)
+	// 创建账户并将用户添加至账户中，可以指定父账户和描述，父账户不存在时会先创建父账户
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	// 以树的形式列出账户的父子关系
+	ListAccountTree(context.Context, *ListAccountTreeRequest) (*ListAccountTreeResponse, error)
+	// 删除账户，可选择先取消账户下未结束的作业，或者只封锁账户并归档账户信息(软删除)
+	// 存在未结束的作业时返回FAILED_PRECONDITION，错误详情的job_ids中列出这些作业
+	// 已归档的账户不能解封或添加用户，也不会出现在账户列表中，对其再次调用且soft_delete为false时彻底删除
+	// 部分用户移出账户失败时账户不会被删除，返回ABORTED，错误详情的removed_users和failed_users中列出已移除和移除失败的用户
+	DeleteAccountWithOptions(context.Context, *DeleteAccountWithOptionsRequest) (*DeleteAccountWithOptionsResponse, error)
+}
+
+// UnimplementedAccountExtServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAccountExtServiceServer struct{}
+
+func (UnimplementedAccountExtServiceServer) GrantAccountPartitions(context.Context, *GrantAccountPartitionsRequest) (*GrantAccountPartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAccountPartitions not implemented")
+}
+func (UnimplementedAccountExtServiceServer) RevokeAccountPartitions(context.Context, *RevokeAccountPartitionsRequest) (*RevokeAccountPartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccountPartitions not implemented")
+}
+func (UnimplementedAccountExtServiceServer) SetAccountQos(context.Context, *SetAccountQosRequest) (*SetAccountQosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountQos not implemented")
+}
+func (UnimplementedAccountExtServiceServer) GetAccountPartitionQos(context.Context, *GetAccountPartitionQosRequest) (*GetAccountPartitionQosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountPartitionQos not implemented")
+}
+func (UnimplementedAccountExtServiceServer) SetAccountLimits(context.Context, *SetAccountLimitsRequest) (*SetAccountLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountLimits not implemented")
+}
+func (UnimplementedAccountExtServiceServer) ClearAccountLimits(context.Context, *ClearAccountLimitsRequest) (*ClearAccountLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearAccountLimits not implemented")
+}
+func (UnimplementedAccountExtServiceServer) GetAccountLimits(context.Context, *GetAccountLimitsRequest) (*GetAccountLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountLimits not implemented")
+}
+func (UnimplementedAccountExtServiceServer) SetUserLimits(context.Context, *SetUserLimitsRequest) (*SetUserLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserLimits not implemented")
+}
+func (UnimplementedAccountExtServiceServer) ClearUserLimits(context.Context, *ClearUserLimitsRequest) (*ClearUserLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearUserLimits not implemented")
+}
+func (UnimplementedAccountExtServiceServer) GetUserLimits(context.Context, *GetUserLimitsRequest) (*GetUserLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserLimits not implemented")
+}
+func (UnimplementedAccountExtServiceServer) ListEffectiveLimits(context.Context, *ListEffectiveLimitsRequest) (*ListEffectiveLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEffectiveLimits not implemented")
+}
+func (UnimplementedAccountExtServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedAccountExtServiceServer) ListAccountTree(context.Context, *ListAccountTreeRequest) (*ListAccountTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountTree not implemented")
+}
+func (UnimplementedAccountExtServiceServer) DeleteAccountWithOptions(context.Context, *DeleteAccountWithOptionsRequest) (*DeleteAccountWithOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccountWithOptions not implemented")
+}
+func (UnimplementedAccountExtServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAccountExtServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountExtServiceServer will
+// result in compilation errors.
+type UnsafeAccountExtServiceServer interface {
+	mustEmbedUnimplementedAccountExtServiceServer()
+}
+
+func RegisterAccountExtServiceServer(s grpc.ServiceRegistrar, srv AccountExtServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAccountExtServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AccountExtService_ServiceDesc, srv)
+}
+
+func _AccountExtService_GrantAccountPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantAccountPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).GrantAccountPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_GrantAccountPartitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).GrantAccountPartitions(ctx, req.(*GrantAccountPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountExtService_RevokeAccountPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccountPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).RevokeAccountPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_RevokeAccountPartitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).RevokeAccountPartitions(ctx, req.(*RevokeAccountPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountExtService_SetAccountQos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountQosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).SetAccountQos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_SetAccountQos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).SetAccountQos(ctx, req.(*SetAccountQosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountExtService_GetAccountPartitionQos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountPartitionQosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).GetAccountPartitionQos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_GetAccountPartitionQos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).GetAccountPartitionQos(ctx, req.(*GetAccountPartitionQosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountExtService_SetAccountLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).SetAccountLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_SetAccountLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).SetAccountLimits(ctx, req.(*SetAccountLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountExtService_ClearAccountLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearAccountLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).ClearAccountLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_ClearAccountLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).ClearAccountLimits(ctx, req.(*ClearAccountLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountExtService_GetAccountLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).GetAccountLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_GetAccountLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).GetAccountLimits(ctx, req.(*GetAccountLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountExtService_SetUserLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).SetUserLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_SetUserLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).SetUserLimits(ctx, req.(*SetUserLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountExtService_ClearUserLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearUserLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).ClearUserLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_ClearUserLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).ClearUserLimits(ctx, req.(*ClearUserLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountExtService_GetUserLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).GetUserLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_GetUserLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).GetUserLimits(ctx, req.(*GetUserLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountExtService_ListEffectiveLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEffectiveLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).ListEffectiveLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_ListEffectiveLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).ListEffectiveLimits(ctx, req.(*ListEffectiveLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountExtService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountExtService_ListAccountTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).ListAccountTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_ListAccountTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).ListAccountTree(ctx, req.(*ListAccountTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountExtService_DeleteAccountWithOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountWithOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountExtServiceServer).DeleteAccountWithOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountExtService_DeleteAccountWithOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountExtServiceServer).DeleteAccountWithOptions(ctx, req.(*DeleteAccountWithOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccountExtService_ServiceDesc is the grpc.ServiceDesc for AccountExtService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccountExtService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scow.crane_adapter.AccountExtService",
+	HandlerType: (*AccountExtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GrantAccountPartitions",
+			Handler:    _AccountExtService_GrantAccountPartitions_Handler,
+		},
+		{
+			MethodName: "RevokeAccountPartitions",
+			Handler:    _AccountExtService_RevokeAccountPartitions_Handler,
+		},
+		{
+			MethodName: "SetAccountQos",
+			Handler:    _AccountExtService_SetAccountQos_Handler,
+		},
+		{
+			MethodName: "GetAccountPartitionQos",
+			Handler:    _AccountExtService_GetAccountPartitionQos_Handler,
+		},
+		{
+			MethodName: "SetAccountLimits",
+			Handler:    _AccountExtService_SetAccountLimits_Handler,
+		},
+		{
+			MethodName: "ClearAccountLimits",
+			Handler:    _AccountExtService_ClearAccountLimits_Handler,
+		},
+		{
+			MethodName: "GetAccountLimits",
+			Handler:    _AccountExtService_GetAccountLimits_Handler,
+		},
+		{
+			MethodName: "SetUserLimits",
+			Handler:    _AccountExtService_SetUserLimits_Handler,
+		},
+		{
+			MethodName: "ClearUserLimits",
+			Handler:    _AccountExtService_ClearUserLimits_Handler,
+		},
+		{
+			MethodName: "GetUserLimits",
+			Handler:    _AccountExtService_GetUserLimits_Handler,
+		},
+		{
+			MethodName: "ListEffectiveLimits",
+			Handler:    _AccountExtService_ListEffectiveLimits_Handler,
+		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _AccountExtService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "ListAccountTree",
+			Handler:    _AccountExtService_ListAccountTree_Handler,
+		},
+		{
+			MethodName: "DeleteAccountWithOptions",
+			Handler:    _AccountExtService_DeleteAccountWithOptions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "adapter/account.proto",
+}
@@ -0,0 +1,468 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: adapter/config.proto
+
+package adapter
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetPartitionShapesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   *string                `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3,oneof" json:"account_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartitionShapesRequest) Reset() {
+	*x = GetPartitionShapesRequest{}
+	mi := &file_adapter_config_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartitionShapesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartitionShapesRequest) ProtoMessage() {}
+
+func (x *GetPartitionShapesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_config_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartitionShapesRequest.ProtoReflect.Descriptor instead.
+func (*GetPartitionShapesRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPartitionShapesRequest) GetAccountName() string {
+	if x != nil && x.AccountName != nil {
+		return *x.AccountName
+	}
+	return ""
+}
+
+type GetPartitionShapesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Partitions    []*PartitionShape      `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartitionShapesResponse) Reset() {
+	*x = GetPartitionShapesResponse{}
+	mi := &file_adapter_config_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartitionShapesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartitionShapesResponse) ProtoMessage() {}
+
+func (x *GetPartitionShapesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_config_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartitionShapesResponse.ProtoReflect.Descriptor instead.
+func (*GetPartitionShapesResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPartitionShapesResponse) GetPartitions() []*PartitionShape {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+type PartitionShape struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Partition string                 `protobuf:"bytes,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	// 分区中不同规格的节点，规格相同的节点合并为一项
+	NodeShapes []*NodeShape `protobuf:"bytes,2,rep,name=node_shapes,json=nodeShapes,proto3" json:"node_shapes,omitempty"`
+	// 单个节点的最大核数和内存，作业单节点申请的资源不能超过该值
+	MaxCoresPerNode uint32 `protobuf:"varint,3,opt,name=max_cores_per_node,json=maxCoresPerNode,proto3" json:"max_cores_per_node,omitempty"`
+	MaxMemMbPerNode uint64 `protobuf:"varint,4,opt,name=max_mem_mb_per_node,json=maxMemMbPerNode,proto3" json:"max_mem_mb_per_node,omitempty"`
+	// 分区中的加速卡型号，来自DeviceMap中的type
+	GpuTypes []string `protobuf:"bytes,5,rep,name=gpu_types,json=gpuTypes,proto3" json:"gpu_types,omitempty"`
+	// 每核默认和最大内存，分区未配置时按节点内存/核数计算
+	DefaultMemPerCoreMb uint64 `protobuf:"varint,6,opt,name=default_mem_per_core_mb,json=defaultMemPerCoreMb,proto3" json:"default_mem_per_core_mb,omitempty"`
+	MaxMemPerCoreMb     uint64 `protobuf:"varint,7,opt,name=max_mem_per_core_mb,json=maxMemPerCoreMb,proto3" json:"max_mem_per_core_mb,omitempty"`
+	// 默认和最大运行时间，来自默认qos和可用qos中的最大值，qos不限制时不返回
+	DefaultTimeLimitSeconds *uint64 `protobuf:"varint,8,opt,name=default_time_limit_seconds,json=defaultTimeLimitSeconds,proto3,oneof" json:"default_time_limit_seconds,omitempty"`
+	MaxTimeLimitSeconds     *uint64 `protobuf:"varint,9,opt,name=max_time_limit_seconds,json=maxTimeLimitSeconds,proto3,oneof" json:"max_time_limit_seconds,omitempty"`
+	// 分区中节点的特性，来自适配器配置的node-features
+	Features      []string `protobuf:"bytes,10,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartitionShape) Reset() {
+	*x = PartitionShape{}
+	mi := &file_adapter_config_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartitionShape) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionShape) ProtoMessage() {}
+
+func (x *PartitionShape) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_config_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionShape.ProtoReflect.Descriptor instead.
+func (*PartitionShape) Descriptor() ([]byte, []int) {
+	return file_adapter_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PartitionShape) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *PartitionShape) GetNodeShapes() []*NodeShape {
+	if x != nil {
+		return x.NodeShapes
+	}
+	return nil
+}
+
+func (x *PartitionShape) GetMaxCoresPerNode() uint32 {
+	if x != nil {
+		return x.MaxCoresPerNode
+	}
+	return 0
+}
+
+func (x *PartitionShape) GetMaxMemMbPerNode() uint64 {
+	if x != nil {
+		return x.MaxMemMbPerNode
+	}
+	return 0
+}
+
+func (x *PartitionShape) GetGpuTypes() []string {
+	if x != nil {
+		return x.GpuTypes
+	}
+	return nil
+}
+
+func (x *PartitionShape) GetDefaultMemPerCoreMb() uint64 {
+	if x != nil {
+		return x.DefaultMemPerCoreMb
+	}
+	return 0
+}
+
+func (x *PartitionShape) GetMaxMemPerCoreMb() uint64 {
+	if x != nil {
+		return x.MaxMemPerCoreMb
+	}
+	return 0
+}
+
+func (x *PartitionShape) GetDefaultTimeLimitSeconds() uint64 {
+	if x != nil && x.DefaultTimeLimitSeconds != nil {
+		return *x.DefaultTimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *PartitionShape) GetMaxTimeLimitSeconds() uint64 {
+	if x != nil && x.MaxTimeLimitSeconds != nil {
+		return *x.MaxTimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *PartitionShape) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type NodeShape struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cores         uint32                 `protobuf:"varint,1,opt,name=cores,proto3" json:"cores,omitempty"`
+	MemMb         uint64                 `protobuf:"varint,2,opt,name=mem_mb,json=memMb,proto3" json:"mem_mb,omitempty"`
+	Devices       []*DeviceCount         `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices,omitempty"`
+	NodeCount     uint32                 `protobuf:"varint,4,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	Features      []string               `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeShape) Reset() {
+	*x = NodeShape{}
+	mi := &file_adapter_config_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeShape) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeShape) ProtoMessage() {}
+
+func (x *NodeShape) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_config_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeShape.ProtoReflect.Descriptor instead.
+func (*NodeShape) Descriptor() ([]byte, []int) {
+	return file_adapter_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NodeShape) GetCores() uint32 {
+	if x != nil {
+		return x.Cores
+	}
+	return 0
+}
+
+func (x *NodeShape) GetMemMb() uint64 {
+	if x != nil {
+		return x.MemMb
+	}
+	return 0
+}
+
+func (x *NodeShape) GetDevices() []*DeviceCount {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *NodeShape) GetNodeCount() uint32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *NodeShape) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type DeviceCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Count         uint32                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceCount) Reset() {
+	*x = DeviceCount{}
+	mi := &file_adapter_config_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceCount) ProtoMessage() {}
+
+func (x *DeviceCount) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_config_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceCount.ProtoReflect.Descriptor instead.
+func (*DeviceCount) Descriptor() ([]byte, []int) {
+	return file_adapter_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeviceCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeviceCount) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DeviceCount) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_adapter_config_proto protoreflect.FileDescriptor
+
+const file_adapter_config_proto_rawDesc = "" +
+	"\n" +
+	"\x14adapter/config.proto\x12\x12scow.crane_adapter\"T\n" +
+	"\x19GetPartitionShapesRequest\x12&\n" +
+	"\faccount_name\x18\x01 \x01(\tH\x00R\vaccountName\x88\x01\x01B\x0f\n" +
+	"\r_account_name\"`\n" +
+	"\x1aGetPartitionShapesResponse\x12B\n" +
+	"\n" +
+	"partitions\x18\x01 \x03(\v2\".scow.crane_adapter.PartitionShapeR\n" +
+	"partitions\"\x9c\x04\n" +
+	"\x0ePartitionShape\x12\x1c\n" +
+	"\tpartition\x18\x01 \x01(\tR\tpartition\x12>\n" +
+	"\vnode_shapes\x18\x02 \x03(\v2\x1d.scow.crane_adapter.NodeShapeR\n" +
+	"nodeShapes\x12+\n" +
+	"\x12max_cores_per_node\x18\x03 \x01(\rR\x0fmaxCoresPerNode\x12,\n" +
+	"\x13max_mem_mb_per_node\x18\x04 \x01(\x04R\x0fmaxMemMbPerNode\x12\x1b\n" +
+	"\tgpu_types\x18\x05 \x03(\tR\bgpuTypes\x124\n" +
+	"\x17default_mem_per_core_mb\x18\x06 \x01(\x04R\x13defaultMemPerCoreMb\x12,\n" +
+	"\x13max_mem_per_core_mb\x18\a \x01(\x04R\x0fmaxMemPerCoreMb\x12@\n" +
+	"\x1adefault_time_limit_seconds\x18\b \x01(\x04H\x00R\x17defaultTimeLimitSeconds\x88\x01\x01\x128\n" +
+	"\x16max_time_limit_seconds\x18\t \x01(\x04H\x01R\x13maxTimeLimitSeconds\x88\x01\x01\x12\x1a\n" +
+	"\bfeatures\x18\n" +
+	" \x03(\tR\bfeaturesB\x1d\n" +
+	"\x1b_default_time_limit_secondsB\x19\n" +
+	"\x17_max_time_limit_seconds\"\xae\x01\n" +
+	"\tNodeShape\x12\x14\n" +
+	"\x05cores\x18\x01 \x01(\rR\x05cores\x12\x15\n" +
+	"\x06mem_mb\x18\x02 \x01(\x04R\x05memMb\x129\n" +
+	"\adevices\x18\x03 \x03(\v2\x1f.scow.crane_adapter.DeviceCountR\adevices\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\x04 \x01(\rR\tnodeCount\x12\x1a\n" +
+	"\bfeatures\x18\x05 \x03(\tR\bfeatures\"K\n" +
+	"\vDeviceCount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\rR\x05count2\x87\x01\n" +
+	"\x10ConfigExtService\x12s\n" +
+	"\x12GetPartitionShapes\x12-.scow.crane_adapter.GetPartitionShapesRequest\x1a..scow.crane_adapter.GetPartitionShapesResponseB Z\x1escow-crane-adapter/gen/adapterb\x06proto3"
+
+var (
+	file_adapter_config_proto_rawDescOnce sync.Once
+	file_adapter_config_proto_rawDescData []byte
+)
+
+func file_adapter_config_proto_rawDescGZIP() []byte {
+	file_adapter_config_proto_rawDescOnce.Do(func() {
+		file_adapter_config_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_adapter_config_proto_rawDesc), len(file_adapter_config_proto_rawDesc)))
+	})
+	return file_adapter_config_proto_rawDescData
+}
+
+var file_adapter_config_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_adapter_config_proto_goTypes = []any{
+	(*GetPartitionShapesRequest)(nil),  // 0: scow.crane_adapter.GetPartitionShapesRequest
+	(*GetPartitionShapesResponse)(nil), // 1: scow.crane_adapter.GetPartitionShapesResponse
+	(*PartitionShape)(nil),             // 2: scow.crane_adapter.PartitionShape
+	(*NodeShape)(nil),                  // 3: scow.crane_adapter.NodeShape
+	(*DeviceCount)(nil),                // 4: scow.crane_adapter.DeviceCount
+}
+var file_adapter_config_proto_depIdxs = []int32{
+	2, // 0: scow.crane_adapter.GetPartitionShapesResponse.partitions:type_name -> scow.crane_adapter.PartitionShape
+	3, // 1: scow.crane_adapter.PartitionShape.node_shapes:type_name -> scow.crane_adapter.NodeShape
+	4, // 2: scow.crane_adapter.NodeShape.devices:type_name -> scow.crane_adapter.DeviceCount
+	0, // 3: scow.crane_adapter.ConfigExtService.GetPartitionShapes:input_type -> scow.crane_adapter.GetPartitionShapesRequest
+	1, // 4: scow.crane_adapter.ConfigExtService.GetPartitionShapes:output_type -> scow.crane_adapter.GetPartitionShapesResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_adapter_config_proto_init() }
+func file_adapter_config_proto_init() {
+	if File_adapter_config_proto != nil {
+		return
+	}
+	file_adapter_config_proto_msgTypes[0].OneofWrappers = []any{}
+	file_adapter_config_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_adapter_config_proto_rawDesc), len(file_adapter_config_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_adapter_config_proto_goTypes,
+		DependencyIndexes: file_adapter_config_proto_depIdxs,
+		MessageInfos:      file_adapter_config_proto_msgTypes,
+	}.Build()
+	File_adapter_config_proto = out.File
+	file_adapter_config_proto_goTypes = nil
+	file_adapter_config_proto_depIdxs = nil
+}
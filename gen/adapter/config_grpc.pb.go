@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: adapter/config.proto
+
+package adapter
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ConfigExtService_GetPartitionShapes_FullMethodName = "/scow.crane_adapter.ConfigExtService/GetPartitionShapes"
+)
+
+// ConfigExtServiceClient is the client API for ConfigExtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 适配器在scow调度器接口之外提供的集群配置扩展接口
+type ConfigExtServiceClient interface {
+	// 查询分区的节点规格，account_name为空时查询集群所有分区，否则查询账户可用的分区并按账户的qos计算运行时间
+	GetPartitionShapes(ctx context.Context, in *GetPartitionShapesRequest, opts ...grpc.CallOption) (*GetPartitionShapesResponse, error)
+}
+
+type configExtServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConfigExtServiceClient(cc grpc.ClientConnInterface) ConfigExtServiceClient {
+	return &configExtServiceClient{cc}
+}
+
+func (c *configExtServiceClient) GetPartitionShapes(ctx context.Context, in *GetPartitionShapesRequest, opts ...grpc.CallOption) (*GetPartitionShapesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPartitionShapesResponse)
+	err := c.cc.Invoke(ctx, ConfigExtService_GetPartitionShapes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConfigExtServiceServer is the server API for ConfigExtService service.
+// All implementations should embed UnimplementedConfigExtServiceServer
+// for forward compatibility.
+//
+// 适配器在scow调度器接口之外提供的集群配置扩展接口
+type ConfigExtServiceServer interface {
+	// 查询分区的节点规格，account_name为空时查询集群所有分区，否则查询账户可用的分区并按账户的qos计算运行时间
+	GetPartitionShapes(context.Context, *GetPartitionShapesRequest) (*GetPartitionShapesResponse, error)
+}
+
+// UnimplementedConfigExtServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConfigExtServiceServer struct{}
+
+func (UnimplementedConfigExtServiceServer) GetPartitionShapes(context.Context, *GetPartitionShapesRequest) (*GetPartitionShapesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartitionShapes not implemented")
+}
+func (UnimplementedConfigExtServiceServer) testEmbeddedByValue() {}
+
+// UnsafeConfigExtServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConfigExtServiceServer will
+// result in compilation errors.
+type UnsafeConfigExtServiceServer interface {
+	mustEmbedUnimplementedConfigExtServiceServer()
+}
+
+func RegisterConfigExtServiceServer(s grpc.ServiceRegistrar, srv ConfigExtServiceServer) {
+	// If the following call pancis, it indicates UnimplementedConfigExtServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConfigExtService_ServiceDesc, srv)
+}
+
+func _ConfigExtService_GetPartitionShapes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartitionShapesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigExtServiceServer).GetPartitionShapes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigExtService_GetPartitionShapes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigExtServiceServer).GetPartitionShapes(ctx, req.(*GetPartitionShapesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConfigExtService_ServiceDesc is the grpc.ServiceDesc for ConfigExtService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConfigExtService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scow.crane_adapter.ConfigExtService",
+	HandlerType: (*ConfigExtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPartitionShapes",
+			Handler:    _ConfigExtService_GetPartitionShapes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "adapter/config.proto",
+}
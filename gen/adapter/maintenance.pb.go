@@ -0,0 +1,724 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: adapter/maintenance.proto
+
+package adapter
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NodeDrainStatus int32
+
+const (
+	// 未到开始时间
+	NodeDrainStatus_DRAIN_PENDING NodeDrainStatus = 0
+	// 已排空，节点上仍有运行中的作业
+	NodeDrainStatus_DRAINING NodeDrainStatus = 1
+	// 节点上已没有运行中的作业，可以开始维护
+	NodeDrainStatus_DRAINED      NodeDrainStatus = 2
+	NodeDrainStatus_DRAIN_FAILED NodeDrainStatus = 3
+)
+
+// Enum value maps for NodeDrainStatus.
+var (
+	NodeDrainStatus_name = map[int32]string{
+		0: "DRAIN_PENDING",
+		1: "DRAINING",
+		2: "DRAINED",
+		3: "DRAIN_FAILED",
+	}
+	NodeDrainStatus_value = map[string]int32{
+		"DRAIN_PENDING": 0,
+		"DRAINING":      1,
+		"DRAINED":       2,
+		"DRAIN_FAILED":  3,
+	}
+)
+
+func (x NodeDrainStatus) Enum() *NodeDrainStatus {
+	p := new(NodeDrainStatus)
+	*p = x
+	return p
+}
+
+func (x NodeDrainStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NodeDrainStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_adapter_maintenance_proto_enumTypes[0].Descriptor()
+}
+
+func (NodeDrainStatus) Type() protoreflect.EnumType {
+	return &file_adapter_maintenance_proto_enumTypes[0]
+}
+
+func (x NodeDrainStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NodeDrainStatus.Descriptor instead.
+func (NodeDrainStatus) EnumDescriptor() ([]byte, []int) {
+	return file_adapter_maintenance_proto_rawDescGZIP(), []int{0}
+}
+
+type NodeDrain struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	DrainId   string                 `protobuf:"bytes,1,opt,name=drain_id,json=drainId,proto3" json:"drain_id,omitempty"`
+	Nodes     []string               `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Reason    string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status    NodeDrainStatus        `protobuf:"varint,5,opt,name=status,proto3,enum=scow.crane_adapter.NodeDrainStatus" json:"status,omitempty"`
+	// 失败原因
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// 节点上的作业全部结束的时间
+	DrainedTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=drained_time,json=drainedTime,proto3,oneof" json:"drained_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeDrain) Reset() {
+	*x = NodeDrain{}
+	mi := &file_adapter_maintenance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeDrain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDrain) ProtoMessage() {}
+
+func (x *NodeDrain) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_maintenance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDrain.ProtoReflect.Descriptor instead.
+func (*NodeDrain) Descriptor() ([]byte, []int) {
+	return file_adapter_maintenance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NodeDrain) GetDrainId() string {
+	if x != nil {
+		return x.DrainId
+	}
+	return ""
+}
+
+func (x *NodeDrain) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *NodeDrain) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *NodeDrain) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *NodeDrain) GetStatus() NodeDrainStatus {
+	if x != nil {
+		return x.Status
+	}
+	return NodeDrainStatus_DRAIN_PENDING
+}
+
+func (x *NodeDrain) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NodeDrain) GetDrainedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DrainedTime
+	}
+	return nil
+}
+
+type ScheduleNodeDrainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []string               `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleNodeDrainRequest) Reset() {
+	*x = ScheduleNodeDrainRequest{}
+	mi := &file_adapter_maintenance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleNodeDrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleNodeDrainRequest) ProtoMessage() {}
+
+func (x *ScheduleNodeDrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_maintenance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleNodeDrainRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleNodeDrainRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_maintenance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduleNodeDrainRequest) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ScheduleNodeDrainRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ScheduleNodeDrainRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ScheduleNodeDrainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DrainId       string                 `protobuf:"bytes,1,opt,name=drain_id,json=drainId,proto3" json:"drain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleNodeDrainResponse) Reset() {
+	*x = ScheduleNodeDrainResponse{}
+	mi := &file_adapter_maintenance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleNodeDrainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleNodeDrainResponse) ProtoMessage() {}
+
+func (x *ScheduleNodeDrainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_maintenance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleNodeDrainResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleNodeDrainResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_maintenance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScheduleNodeDrainResponse) GetDrainId() string {
+	if x != nil {
+		return x.DrainId
+	}
+	return ""
+}
+
+type CancelNodeDrainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DrainId       string                 `protobuf:"bytes,1,opt,name=drain_id,json=drainId,proto3" json:"drain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelNodeDrainRequest) Reset() {
+	*x = CancelNodeDrainRequest{}
+	mi := &file_adapter_maintenance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelNodeDrainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelNodeDrainRequest) ProtoMessage() {}
+
+func (x *CancelNodeDrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_maintenance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelNodeDrainRequest.ProtoReflect.Descriptor instead.
+func (*CancelNodeDrainRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_maintenance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CancelNodeDrainRequest) GetDrainId() string {
+	if x != nil {
+		return x.DrainId
+	}
+	return ""
+}
+
+type CancelNodeDrainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelNodeDrainResponse) Reset() {
+	*x = CancelNodeDrainResponse{}
+	mi := &file_adapter_maintenance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelNodeDrainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelNodeDrainResponse) ProtoMessage() {}
+
+func (x *CancelNodeDrainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_maintenance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelNodeDrainResponse.ProtoReflect.Descriptor instead.
+func (*CancelNodeDrainResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_maintenance_proto_rawDescGZIP(), []int{4}
+}
+
+type ListNodeDrainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNodeDrainsRequest) Reset() {
+	*x = ListNodeDrainsRequest{}
+	mi := &file_adapter_maintenance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodeDrainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodeDrainsRequest) ProtoMessage() {}
+
+func (x *ListNodeDrainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_maintenance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodeDrainsRequest.ProtoReflect.Descriptor instead.
+func (*ListNodeDrainsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_maintenance_proto_rawDescGZIP(), []int{5}
+}
+
+type ListNodeDrainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drains        []*NodeDrain           `protobuf:"bytes,1,rep,name=drains,proto3" json:"drains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNodeDrainsResponse) Reset() {
+	*x = ListNodeDrainsResponse{}
+	mi := &file_adapter_maintenance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodeDrainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodeDrainsResponse) ProtoMessage() {}
+
+func (x *ListNodeDrainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_maintenance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodeDrainsResponse.ProtoReflect.Descriptor instead.
+func (*ListNodeDrainsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_maintenance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListNodeDrainsResponse) GetDrains() []*NodeDrain {
+	if x != nil {
+		return x.Drains
+	}
+	return nil
+}
+
+type ListNodeMaintenanceStatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNodeMaintenanceStatesRequest) Reset() {
+	*x = ListNodeMaintenanceStatesRequest{}
+	mi := &file_adapter_maintenance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodeMaintenanceStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodeMaintenanceStatesRequest) ProtoMessage() {}
+
+func (x *ListNodeMaintenanceStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_maintenance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodeMaintenanceStatesRequest.ProtoReflect.Descriptor instead.
+func (*ListNodeMaintenanceStatesRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_maintenance_proto_rawDescGZIP(), []int{7}
+}
+
+type ListNodeMaintenanceStatesResponse struct {
+	state         protoimpl.MessageState                               `protogen:"open.v1"`
+	Nodes         []*ListNodeMaintenanceStatesResponse_NodeMaintenance `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNodeMaintenanceStatesResponse) Reset() {
+	*x = ListNodeMaintenanceStatesResponse{}
+	mi := &file_adapter_maintenance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodeMaintenanceStatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodeMaintenanceStatesResponse) ProtoMessage() {}
+
+func (x *ListNodeMaintenanceStatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_maintenance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodeMaintenanceStatesResponse.ProtoReflect.Descriptor instead.
+func (*ListNodeMaintenanceStatesResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_maintenance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListNodeMaintenanceStatesResponse) GetNodes() []*ListNodeMaintenanceStatesResponse_NodeMaintenance {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type ListNodeMaintenanceStatesResponse_NodeMaintenance struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	NodeName string                 `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	// 节点在鹤思中处于DRAIN状态
+	Drained bool `protobuf:"varint,2,opt,name=drained,proto3" json:"drained,omitempty"`
+	// 适配器计划的排空原因，在适配器之外排空的节点为空
+	Reason        string           `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	DrainId       *string          `protobuf:"bytes,4,opt,name=drain_id,json=drainId,proto3,oneof" json:"drain_id,omitempty"`
+	Status        *NodeDrainStatus `protobuf:"varint,5,opt,name=status,proto3,enum=scow.crane_adapter.NodeDrainStatus,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNodeMaintenanceStatesResponse_NodeMaintenance) Reset() {
+	*x = ListNodeMaintenanceStatesResponse_NodeMaintenance{}
+	mi := &file_adapter_maintenance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodeMaintenanceStatesResponse_NodeMaintenance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodeMaintenanceStatesResponse_NodeMaintenance) ProtoMessage() {}
+
+func (x *ListNodeMaintenanceStatesResponse_NodeMaintenance) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_maintenance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodeMaintenanceStatesResponse_NodeMaintenance.ProtoReflect.Descriptor instead.
+func (*ListNodeMaintenanceStatesResponse_NodeMaintenance) Descriptor() ([]byte, []int) {
+	return file_adapter_maintenance_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *ListNodeMaintenanceStatesResponse_NodeMaintenance) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *ListNodeMaintenanceStatesResponse_NodeMaintenance) GetDrained() bool {
+	if x != nil {
+		return x.Drained
+	}
+	return false
+}
+
+func (x *ListNodeMaintenanceStatesResponse_NodeMaintenance) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ListNodeMaintenanceStatesResponse_NodeMaintenance) GetDrainId() string {
+	if x != nil && x.DrainId != nil {
+		return *x.DrainId
+	}
+	return ""
+}
+
+func (x *ListNodeMaintenanceStatesResponse_NodeMaintenance) GetStatus() NodeDrainStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return NodeDrainStatus_DRAIN_PENDING
+}
+
+var File_adapter_maintenance_proto protoreflect.FileDescriptor
+
+const file_adapter_maintenance_proto_rawDesc = "" +
+	"\n" +
+	"\x19adapter/maintenance.proto\x12\x12scow.crane_adapter\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbb\x02\n" +
+	"\tNodeDrain\x12\x19\n" +
+	"\bdrain_id\x18\x01 \x01(\tR\adrainId\x12\x14\n" +
+	"\x05nodes\x18\x02 \x03(\tR\x05nodes\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12;\n" +
+	"\x06status\x18\x05 \x01(\x0e2#.scow.crane_adapter.NodeDrainStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12B\n" +
+	"\fdrained_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vdrainedTime\x88\x01\x01B\x0f\n" +
+	"\r_drained_time\"\x97\x01\n" +
+	"\x18ScheduleNodeDrainRequest\x12\x14\n" +
+	"\x05nodes\x18\x01 \x03(\tR\x05nodes\x12>\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reasonB\r\n" +
+	"\v_start_time\"6\n" +
+	"\x19ScheduleNodeDrainResponse\x12\x19\n" +
+	"\bdrain_id\x18\x01 \x01(\tR\adrainId\"3\n" +
+	"\x16CancelNodeDrainRequest\x12\x19\n" +
+	"\bdrain_id\x18\x01 \x01(\tR\adrainId\"\x19\n" +
+	"\x17CancelNodeDrainResponse\"\x17\n" +
+	"\x15ListNodeDrainsRequest\"O\n" +
+	"\x16ListNodeDrainsResponse\x125\n" +
+	"\x06drains\x18\x01 \x03(\v2\x1d.scow.crane_adapter.NodeDrainR\x06drains\"\"\n" +
+	" ListNodeMaintenanceStatesRequest\"\xdd\x02\n" +
+	"!ListNodeMaintenanceStatesResponse\x12[\n" +
+	"\x05nodes\x18\x01 \x03(\v2E.scow.crane_adapter.ListNodeMaintenanceStatesResponse.NodeMaintenanceR\x05nodes\x1a\xda\x01\n" +
+	"\x0fNodeMaintenance\x12\x1b\n" +
+	"\tnode_name\x18\x01 \x01(\tR\bnodeName\x12\x18\n" +
+	"\adrained\x18\x02 \x01(\bR\adrained\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1e\n" +
+	"\bdrain_id\x18\x04 \x01(\tH\x00R\adrainId\x88\x01\x01\x12@\n" +
+	"\x06status\x18\x05 \x01(\x0e2#.scow.crane_adapter.NodeDrainStatusH\x01R\x06status\x88\x01\x01B\v\n" +
+	"\t_drain_idB\t\n" +
+	"\a_status*Q\n" +
+	"\x0fNodeDrainStatus\x12\x11\n" +
+	"\rDRAIN_PENDING\x10\x00\x12\f\n" +
+	"\bDRAINING\x10\x01\x12\v\n" +
+	"\aDRAINED\x10\x02\x12\x10\n" +
+	"\fDRAIN_FAILED\x10\x032\xe6\x03\n" +
+	"\x12MaintenanceService\x12p\n" +
+	"\x11ScheduleNodeDrain\x12,.scow.crane_adapter.ScheduleNodeDrainRequest\x1a-.scow.crane_adapter.ScheduleNodeDrainResponse\x12j\n" +
+	"\x0fCancelNodeDrain\x12*.scow.crane_adapter.CancelNodeDrainRequest\x1a+.scow.crane_adapter.CancelNodeDrainResponse\x12g\n" +
+	"\x0eListNodeDrains\x12).scow.crane_adapter.ListNodeDrainsRequest\x1a*.scow.crane_adapter.ListNodeDrainsResponse\x12\x88\x01\n" +
+	"\x19ListNodeMaintenanceStates\x124.scow.crane_adapter.ListNodeMaintenanceStatesRequest\x1a5.scow.crane_adapter.ListNodeMaintenanceStatesResponseB Z\x1escow-crane-adapter/gen/adapterb\x06proto3"
+
+var (
+	file_adapter_maintenance_proto_rawDescOnce sync.Once
+	file_adapter_maintenance_proto_rawDescData []byte
+)
+
+func file_adapter_maintenance_proto_rawDescGZIP() []byte {
+	file_adapter_maintenance_proto_rawDescOnce.Do(func() {
+		file_adapter_maintenance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_adapter_maintenance_proto_rawDesc), len(file_adapter_maintenance_proto_rawDesc)))
+	})
+	return file_adapter_maintenance_proto_rawDescData
+}
+
+var file_adapter_maintenance_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_adapter_maintenance_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_adapter_maintenance_proto_goTypes = []any{
+	(NodeDrainStatus)(0),                                      // 0: scow.crane_adapter.NodeDrainStatus
+	(*NodeDrain)(nil),                                         // 1: scow.crane_adapter.NodeDrain
+	(*ScheduleNodeDrainRequest)(nil),                          // 2: scow.crane_adapter.ScheduleNodeDrainRequest
+	(*ScheduleNodeDrainResponse)(nil),                         // 3: scow.crane_adapter.ScheduleNodeDrainResponse
+	(*CancelNodeDrainRequest)(nil),                            // 4: scow.crane_adapter.CancelNodeDrainRequest
+	(*CancelNodeDrainResponse)(nil),                           // 5: scow.crane_adapter.CancelNodeDrainResponse
+	(*ListNodeDrainsRequest)(nil),                             // 6: scow.crane_adapter.ListNodeDrainsRequest
+	(*ListNodeDrainsResponse)(nil),                            // 7: scow.crane_adapter.ListNodeDrainsResponse
+	(*ListNodeMaintenanceStatesRequest)(nil),                  // 8: scow.crane_adapter.ListNodeMaintenanceStatesRequest
+	(*ListNodeMaintenanceStatesResponse)(nil),                 // 9: scow.crane_adapter.ListNodeMaintenanceStatesResponse
+	(*ListNodeMaintenanceStatesResponse_NodeMaintenance)(nil), // 10: scow.crane_adapter.ListNodeMaintenanceStatesResponse.NodeMaintenance
+	(*timestamppb.Timestamp)(nil),                             // 11: google.protobuf.Timestamp
+}
+var file_adapter_maintenance_proto_depIdxs = []int32{
+	11, // 0: scow.crane_adapter.NodeDrain.start_time:type_name -> google.protobuf.Timestamp
+	0,  // 1: scow.crane_adapter.NodeDrain.status:type_name -> scow.crane_adapter.NodeDrainStatus
+	11, // 2: scow.crane_adapter.NodeDrain.drained_time:type_name -> google.protobuf.Timestamp
+	11, // 3: scow.crane_adapter.ScheduleNodeDrainRequest.start_time:type_name -> google.protobuf.Timestamp
+	1,  // 4: scow.crane_adapter.ListNodeDrainsResponse.drains:type_name -> scow.crane_adapter.NodeDrain
+	10, // 5: scow.crane_adapter.ListNodeMaintenanceStatesResponse.nodes:type_name -> scow.crane_adapter.ListNodeMaintenanceStatesResponse.NodeMaintenance
+	0,  // 6: scow.crane_adapter.ListNodeMaintenanceStatesResponse.NodeMaintenance.status:type_name -> scow.crane_adapter.NodeDrainStatus
+	2,  // 7: scow.crane_adapter.MaintenanceService.ScheduleNodeDrain:input_type -> scow.crane_adapter.ScheduleNodeDrainRequest
+	4,  // 8: scow.crane_adapter.MaintenanceService.CancelNodeDrain:input_type -> scow.crane_adapter.CancelNodeDrainRequest
+	6,  // 9: scow.crane_adapter.MaintenanceService.ListNodeDrains:input_type -> scow.crane_adapter.ListNodeDrainsRequest
+	8,  // 10: scow.crane_adapter.MaintenanceService.ListNodeMaintenanceStates:input_type -> scow.crane_adapter.ListNodeMaintenanceStatesRequest
+	3,  // 11: scow.crane_adapter.MaintenanceService.ScheduleNodeDrain:output_type -> scow.crane_adapter.ScheduleNodeDrainResponse
+	5,  // 12: scow.crane_adapter.MaintenanceService.CancelNodeDrain:output_type -> scow.crane_adapter.CancelNodeDrainResponse
+	7,  // 13: scow.crane_adapter.MaintenanceService.ListNodeDrains:output_type -> scow.crane_adapter.ListNodeDrainsResponse
+	9,  // 14: scow.crane_adapter.MaintenanceService.ListNodeMaintenanceStates:output_type -> scow.crane_adapter.ListNodeMaintenanceStatesResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_adapter_maintenance_proto_init() }
+func file_adapter_maintenance_proto_init() {
+	if File_adapter_maintenance_proto != nil {
+		return
+	}
+	file_adapter_maintenance_proto_msgTypes[0].OneofWrappers = []any{}
+	file_adapter_maintenance_proto_msgTypes[1].OneofWrappers = []any{}
+	file_adapter_maintenance_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_adapter_maintenance_proto_rawDesc), len(file_adapter_maintenance_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_adapter_maintenance_proto_goTypes,
+		DependencyIndexes: file_adapter_maintenance_proto_depIdxs,
+		EnumInfos:         file_adapter_maintenance_proto_enumTypes,
+		MessageInfos:      file_adapter_maintenance_proto_msgTypes,
+	}.Build()
+	File_adapter_maintenance_proto = out.File
+	file_adapter_maintenance_proto_goTypes = nil
+	file_adapter_maintenance_proto_depIdxs = nil
+}
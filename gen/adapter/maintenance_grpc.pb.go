@@ -0,0 +1,245 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: adapter/maintenance.proto
+
+package adapter
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MaintenanceService_ScheduleNodeDrain_FullMethodName         = "/scow.crane_adapter.MaintenanceService/ScheduleNodeDrain"
+	MaintenanceService_CancelNodeDrain_FullMethodName           = "/scow.crane_adapter.MaintenanceService/CancelNodeDrain"
+	MaintenanceService_ListNodeDrains_FullMethodName            = "/scow.crane_adapter.MaintenanceService/ListNodeDrains"
+	MaintenanceService_ListNodeMaintenanceStates_FullMethodName = "/scow.crane_adapter.MaintenanceService/ListNodeMaintenanceStates"
+)
+
+// MaintenanceServiceClient is the client API for MaintenanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 适配器提供的节点维护接口，按计划在指定时间排空(drain)节点
+type MaintenanceServiceClient interface {
+	// 计划在start_time排空节点，start_time为空时立即排空，计划保存在适配器中，重启后继续执行
+	ScheduleNodeDrain(ctx context.Context, in *ScheduleNodeDrainRequest, opts ...grpc.CallOption) (*ScheduleNodeDrainResponse, error)
+	// 取消计划，已经排空的节点恢复为可调度
+	CancelNodeDrain(ctx context.Context, in *CancelNodeDrainRequest, opts ...grpc.CallOption) (*CancelNodeDrainResponse, error)
+	// 查询所有计划及其状态
+	ListNodeDrains(ctx context.Context, in *ListNodeDrainsRequest, opts ...grpc.CallOption) (*ListNodeDrainsResponse, error)
+	// 查询节点的排空状态和原因，包括在适配器之外排空的节点，未排空且没有计划的节点不在其中
+	ListNodeMaintenanceStates(ctx context.Context, in *ListNodeMaintenanceStatesRequest, opts ...grpc.CallOption) (*ListNodeMaintenanceStatesResponse, error)
+}
+
+type maintenanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMaintenanceServiceClient(cc grpc.ClientConnInterface) MaintenanceServiceClient {
+	return &maintenanceServiceClient{cc}
+}
+
+func (c *maintenanceServiceClient) ScheduleNodeDrain(ctx context.Context, in *ScheduleNodeDrainRequest, opts ...grpc.CallOption) (*ScheduleNodeDrainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleNodeDrainResponse)
+	err := c.cc.Invoke(ctx, MaintenanceService_ScheduleNodeDrain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceServiceClient) CancelNodeDrain(ctx context.Context, in *CancelNodeDrainRequest, opts ...grpc.CallOption) (*CancelNodeDrainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelNodeDrainResponse)
+	err := c.cc.Invoke(ctx, MaintenanceService_CancelNodeDrain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceServiceClient) ListNodeDrains(ctx context.Context, in *ListNodeDrainsRequest, opts ...grpc.CallOption) (*ListNodeDrainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNodeDrainsResponse)
+	err := c.cc.Invoke(ctx, MaintenanceService_ListNodeDrains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceServiceClient) ListNodeMaintenanceStates(ctx context.Context, in *ListNodeMaintenanceStatesRequest, opts ...grpc.CallOption) (*ListNodeMaintenanceStatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNodeMaintenanceStatesResponse)
+	err := c.cc.Invoke(ctx, MaintenanceService_ListNodeMaintenanceStates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MaintenanceServiceServer is the server API for MaintenanceService service.
+// All implementations should embed UnimplementedMaintenanceServiceServer
+// for forward compatibility.
+//
+// 适配器提供的节点维护接口，按计划在指定时间排空(drain)节点
+type MaintenanceServiceServer interface {
+	// 计划在start_time排空节点，start_time为空时立即排空，计划保存在适配器中，重启后继续执行
+	ScheduleNodeDrain(context.Context, *ScheduleNodeDrainRequest) (*ScheduleNodeDrainResponse, error)
+	// 取消计划，已经排空的节点恢复为可调度
+	CancelNodeDrain(context.Context, *CancelNodeDrainRequest) (*CancelNodeDrainResponse, error)
+	// 查询所有计划及其状态
+	ListNodeDrains(context.Context, *ListNodeDrainsRequest) (*ListNodeDrainsResponse, error)
+	// 查询节点的排空状态和原因，包括在适配器之外排空的节点，未排空且没有计划的节点不在其中
+	ListNodeMaintenanceStates(context.Context, *ListNodeMaintenanceStatesRequest) (*ListNodeMaintenanceStatesResponse, error)
+}
+
+// UnimplementedMaintenanceServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMaintenanceServiceServer struct{}
+
+func (UnimplementedMaintenanceServiceServer) ScheduleNodeDrain(context.Context, *ScheduleNodeDrainRequest) (*ScheduleNodeDrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleNodeDrain not implemented")
+}
+func (UnimplementedMaintenanceServiceServer) CancelNodeDrain(context.Context, *CancelNodeDrainRequest) (*CancelNodeDrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelNodeDrain not implemented")
+}
+func (UnimplementedMaintenanceServiceServer) ListNodeDrains(context.Context, *ListNodeDrainsRequest) (*ListNodeDrainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodeDrains not implemented")
+}
+func (UnimplementedMaintenanceServiceServer) ListNodeMaintenanceStates(context.Context, *ListNodeMaintenanceStatesRequest) (*ListNodeMaintenanceStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodeMaintenanceStates not implemented")
+}
+func (UnimplementedMaintenanceServiceServer) testEmbeddedByValue() {}
+
+// UnsafeMaintenanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MaintenanceServiceServer will
+// result in compilation errors.
+type UnsafeMaintenanceServiceServer interface {
+	mustEmbedUnimplementedMaintenanceServiceServer()
+}
+
+func RegisterMaintenanceServiceServer(s grpc.ServiceRegistrar, srv MaintenanceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMaintenanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MaintenanceService_ServiceDesc, srv)
+}
+
+func _MaintenanceService_ScheduleNodeDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleNodeDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).ScheduleNodeDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceService_ScheduleNodeDrain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).ScheduleNodeDrain(ctx, req.(*ScheduleNodeDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceService_CancelNodeDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelNodeDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).CancelNodeDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceService_CancelNodeDrain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).CancelNodeDrain(ctx, req.(*CancelNodeDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceService_ListNodeDrains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodeDrainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).ListNodeDrains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceService_ListNodeDrains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).ListNodeDrains(ctx, req.(*ListNodeDrainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceService_ListNodeMaintenanceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodeMaintenanceStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).ListNodeMaintenanceStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceService_ListNodeMaintenanceStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).ListNodeMaintenanceStates(ctx, req.(*ListNodeMaintenanceStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MaintenanceService_ServiceDesc is the grpc.ServiceDesc for MaintenanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MaintenanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scow.crane_adapter.MaintenanceService",
+	HandlerType: (*MaintenanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleNodeDrain",
+			Handler:    _MaintenanceService_ScheduleNodeDrain_Handler,
+		},
+		{
+			MethodName: "CancelNodeDrain",
+			Handler:    _MaintenanceService_CancelNodeDrain_Handler,
+		},
+		{
+			MethodName: "ListNodeDrains",
+			Handler:    _MaintenanceService_ListNodeDrains_Handler,
+		},
+		{
+			MethodName: "ListNodeMaintenanceStates",
+			Handler:    _MaintenanceService_ListNodeMaintenanceStates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "adapter/maintenance.proto",
+}
@@ -0,0 +1,686 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: adapter/reservation.proto
+
+package adapter
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReservationInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReservationName string                 `protobuf:"bytes,1,opt,name=reservation_name,json=reservationName,proto3" json:"reservation_name,omitempty"`
+	Partition       string                 `protobuf:"bytes,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	// 节点列表，如cn[01-04]
+	NodeList        string                 `protobuf:"bytes,3,opt,name=node_list,json=nodeList,proto3" json:"node_list,omitempty"`
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// 只有允许的账户可以使用预留中的节点提交作业
+	AllowedAccounts []string `protobuf:"bytes,6,rep,name=allowed_accounts,json=allowedAccounts,proto3" json:"allowed_accounts,omitempty"`
+	AllowedUsers    []string `protobuf:"bytes,7,rep,name=allowed_users,json=allowedUsers,proto3" json:"allowed_users,omitempty"`
+	// 以下为查询时返回的资源信息，创建时忽略
+	TotalCpuCores uint32 `protobuf:"varint,8,opt,name=total_cpu_cores,json=totalCpuCores,proto3" json:"total_cpu_cores,omitempty"`
+	AllocCpuCores uint32 `protobuf:"varint,9,opt,name=alloc_cpu_cores,json=allocCpuCores,proto3" json:"alloc_cpu_cores,omitempty"`
+	AvailCpuCores uint32 `protobuf:"varint,10,opt,name=avail_cpu_cores,json=availCpuCores,proto3" json:"avail_cpu_cores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationInfo) Reset() {
+	*x = ReservationInfo{}
+	mi := &file_adapter_reservation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationInfo) ProtoMessage() {}
+
+func (x *ReservationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_reservation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationInfo.ProtoReflect.Descriptor instead.
+func (*ReservationInfo) Descriptor() ([]byte, []int) {
+	return file_adapter_reservation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReservationInfo) GetReservationName() string {
+	if x != nil {
+		return x.ReservationName
+	}
+	return ""
+}
+
+func (x *ReservationInfo) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *ReservationInfo) GetNodeList() string {
+	if x != nil {
+		return x.NodeList
+	}
+	return ""
+}
+
+func (x *ReservationInfo) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ReservationInfo) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *ReservationInfo) GetAllowedAccounts() []string {
+	if x != nil {
+		return x.AllowedAccounts
+	}
+	return nil
+}
+
+func (x *ReservationInfo) GetAllowedUsers() []string {
+	if x != nil {
+		return x.AllowedUsers
+	}
+	return nil
+}
+
+func (x *ReservationInfo) GetTotalCpuCores() uint32 {
+	if x != nil {
+		return x.TotalCpuCores
+	}
+	return 0
+}
+
+func (x *ReservationInfo) GetAllocCpuCores() uint32 {
+	if x != nil {
+		return x.AllocCpuCores
+	}
+	return 0
+}
+
+func (x *ReservationInfo) GetAvailCpuCores() uint32 {
+	if x != nil {
+		return x.AvailCpuCores
+	}
+	return 0
+}
+
+type CreateReservationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReservationName string                 `protobuf:"bytes,1,opt,name=reservation_name,json=reservationName,proto3" json:"reservation_name,omitempty"`
+	Partition       string                 `protobuf:"bytes,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	NodeList        string                 `protobuf:"bytes,3,opt,name=node_list,json=nodeList,proto3" json:"node_list,omitempty"`
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	AllowedAccounts []string               `protobuf:"bytes,6,rep,name=allowed_accounts,json=allowedAccounts,proto3" json:"allowed_accounts,omitempty"`
+	AllowedUsers    []string               `protobuf:"bytes,7,rep,name=allowed_users,json=allowedUsers,proto3" json:"allowed_users,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateReservationRequest) Reset() {
+	*x = CreateReservationRequest{}
+	mi := &file_adapter_reservation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReservationRequest) ProtoMessage() {}
+
+func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_reservation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReservationRequest.ProtoReflect.Descriptor instead.
+func (*CreateReservationRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_reservation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateReservationRequest) GetReservationName() string {
+	if x != nil {
+		return x.ReservationName
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetNodeList() string {
+	if x != nil {
+		return x.NodeList
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateReservationRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CreateReservationRequest) GetAllowedAccounts() []string {
+	if x != nil {
+		return x.AllowedAccounts
+	}
+	return nil
+}
+
+func (x *CreateReservationRequest) GetAllowedUsers() []string {
+	if x != nil {
+		return x.AllowedUsers
+	}
+	return nil
+}
+
+type CreateReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReservationResponse) Reset() {
+	*x = CreateReservationResponse{}
+	mi := &file_adapter_reservation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReservationResponse) ProtoMessage() {}
+
+func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_reservation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReservationResponse.ProtoReflect.Descriptor instead.
+func (*CreateReservationResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_reservation_proto_rawDescGZIP(), []int{2}
+}
+
+type DeleteReservationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReservationName string                 `protobuf:"bytes,1,opt,name=reservation_name,json=reservationName,proto3" json:"reservation_name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteReservationRequest) Reset() {
+	*x = DeleteReservationRequest{}
+	mi := &file_adapter_reservation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReservationRequest) ProtoMessage() {}
+
+func (x *DeleteReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_reservation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReservationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReservationRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_reservation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteReservationRequest) GetReservationName() string {
+	if x != nil {
+		return x.ReservationName
+	}
+	return ""
+}
+
+type DeleteReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReservationResponse) Reset() {
+	*x = DeleteReservationResponse{}
+	mi := &file_adapter_reservation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReservationResponse) ProtoMessage() {}
+
+func (x *DeleteReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_reservation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReservationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReservationResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_reservation_proto_rawDescGZIP(), []int{4}
+}
+
+type ListReservationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsRequest) Reset() {
+	*x = ListReservationsRequest{}
+	mi := &file_adapter_reservation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsRequest) ProtoMessage() {}
+
+func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_reservation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ListReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_reservation_proto_rawDescGZIP(), []int{5}
+}
+
+type ListReservationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservations  []*ReservationInfo     `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsResponse) Reset() {
+	*x = ListReservationsResponse{}
+	mi := &file_adapter_reservation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsResponse) ProtoMessage() {}
+
+func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_reservation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_reservation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListReservationsResponse) GetReservations() []*ReservationInfo {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+type GetReservationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReservationName string                 `protobuf:"bytes,1,opt,name=reservation_name,json=reservationName,proto3" json:"reservation_name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetReservationRequest) Reset() {
+	*x = GetReservationRequest{}
+	mi := &file_adapter_reservation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationRequest) ProtoMessage() {}
+
+func (x *GetReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_reservation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationRequest.ProtoReflect.Descriptor instead.
+func (*GetReservationRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_reservation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetReservationRequest) GetReservationName() string {
+	if x != nil {
+		return x.ReservationName
+	}
+	return ""
+}
+
+type GetReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *ReservationInfo       `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReservationResponse) Reset() {
+	*x = GetReservationResponse{}
+	mi := &file_adapter_reservation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationResponse) ProtoMessage() {}
+
+func (x *GetReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_reservation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationResponse.ProtoReflect.Descriptor instead.
+func (*GetReservationResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_reservation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetReservationResponse) GetReservation() *ReservationInfo {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+// GetJobById和GetJobs通过响应头job-reservations-bin返回作业使用的预留，未使用预留的作业不在其中
+type JobReservations struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservations  map[uint32]string      `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobReservations) Reset() {
+	*x = JobReservations{}
+	mi := &file_adapter_reservation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobReservations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobReservations) ProtoMessage() {}
+
+func (x *JobReservations) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_reservation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobReservations.ProtoReflect.Descriptor instead.
+func (*JobReservations) Descriptor() ([]byte, []int) {
+	return file_adapter_reservation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *JobReservations) GetReservations() map[uint32]string {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+var File_adapter_reservation_proto protoreflect.FileDescriptor
+
+const file_adapter_reservation_proto_rawDesc = "" +
+	"\n" +
+	"\x19adapter/reservation.proto\x12\x12scow.crane_adapter\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x03\n" +
+	"\x0fReservationInfo\x12)\n" +
+	"\x10reservation_name\x18\x01 \x01(\tR\x0freservationName\x12\x1c\n" +
+	"\tpartition\x18\x02 \x01(\tR\tpartition\x12\x1b\n" +
+	"\tnode_list\x18\x03 \x01(\tR\bnodeList\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12)\n" +
+	"\x10duration_seconds\x18\x05 \x01(\x03R\x0fdurationSeconds\x12)\n" +
+	"\x10allowed_accounts\x18\x06 \x03(\tR\x0fallowedAccounts\x12#\n" +
+	"\rallowed_users\x18\a \x03(\tR\fallowedUsers\x12&\n" +
+	"\x0ftotal_cpu_cores\x18\b \x01(\rR\rtotalCpuCores\x12&\n" +
+	"\x0falloc_cpu_cores\x18\t \x01(\rR\rallocCpuCores\x12&\n" +
+	"\x0favail_cpu_cores\x18\n" +
+	" \x01(\rR\ravailCpuCores\"\xca\x02\n" +
+	"\x18CreateReservationRequest\x12)\n" +
+	"\x10reservation_name\x18\x01 \x01(\tR\x0freservationName\x12\x1c\n" +
+	"\tpartition\x18\x02 \x01(\tR\tpartition\x12\x1b\n" +
+	"\tnode_list\x18\x03 \x01(\tR\bnodeList\x12>\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12)\n" +
+	"\x10duration_seconds\x18\x05 \x01(\x03R\x0fdurationSeconds\x12)\n" +
+	"\x10allowed_accounts\x18\x06 \x03(\tR\x0fallowedAccounts\x12#\n" +
+	"\rallowed_users\x18\a \x03(\tR\fallowedUsersB\r\n" +
+	"\v_start_time\"\x1b\n" +
+	"\x19CreateReservationResponse\"E\n" +
+	"\x18DeleteReservationRequest\x12)\n" +
+	"\x10reservation_name\x18\x01 \x01(\tR\x0freservationName\"\x1b\n" +
+	"\x19DeleteReservationResponse\"\x19\n" +
+	"\x17ListReservationsRequest\"c\n" +
+	"\x18ListReservationsResponse\x12G\n" +
+	"\freservations\x18\x01 \x03(\v2#.scow.crane_adapter.ReservationInfoR\freservations\"B\n" +
+	"\x15GetReservationRequest\x12)\n" +
+	"\x10reservation_name\x18\x01 \x01(\tR\x0freservationName\"_\n" +
+	"\x16GetReservationResponse\x12E\n" +
+	"\vreservation\x18\x01 \x01(\v2#.scow.crane_adapter.ReservationInfoR\vreservation\"\xad\x01\n" +
+	"\x0fJobReservations\x12Y\n" +
+	"\freservations\x18\x01 \x03(\v25.scow.crane_adapter.JobReservations.ReservationsEntryR\freservations\x1a?\n" +
+	"\x11ReservationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\rR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xd0\x03\n" +
+	"\x12ReservationService\x12p\n" +
+	"\x11CreateReservation\x12,.scow.crane_adapter.CreateReservationRequest\x1a-.scow.crane_adapter.CreateReservationResponse\x12p\n" +
+	"\x11DeleteReservation\x12,.scow.crane_adapter.DeleteReservationRequest\x1a-.scow.crane_adapter.DeleteReservationResponse\x12m\n" +
+	"\x10ListReservations\x12+.scow.crane_adapter.ListReservationsRequest\x1a,.scow.crane_adapter.ListReservationsResponse\x12g\n" +
+	"\x0eGetReservation\x12).scow.crane_adapter.GetReservationRequest\x1a*.scow.crane_adapter.GetReservationResponseB Z\x1escow-crane-adapter/gen/adapterb\x06proto3"
+
+var (
+	file_adapter_reservation_proto_rawDescOnce sync.Once
+	file_adapter_reservation_proto_rawDescData []byte
+)
+
+func file_adapter_reservation_proto_rawDescGZIP() []byte {
+	file_adapter_reservation_proto_rawDescOnce.Do(func() {
+		file_adapter_reservation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_adapter_reservation_proto_rawDesc), len(file_adapter_reservation_proto_rawDesc)))
+	})
+	return file_adapter_reservation_proto_rawDescData
+}
+
+var file_adapter_reservation_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_adapter_reservation_proto_goTypes = []any{
+	(*ReservationInfo)(nil),           // 0: scow.crane_adapter.ReservationInfo
+	(*CreateReservationRequest)(nil),  // 1: scow.crane_adapter.CreateReservationRequest
+	(*CreateReservationResponse)(nil), // 2: scow.crane_adapter.CreateReservationResponse
+	(*DeleteReservationRequest)(nil),  // 3: scow.crane_adapter.DeleteReservationRequest
+	(*DeleteReservationResponse)(nil), // 4: scow.crane_adapter.DeleteReservationResponse
+	(*ListReservationsRequest)(nil),   // 5: scow.crane_adapter.ListReservationsRequest
+	(*ListReservationsResponse)(nil),  // 6: scow.crane_adapter.ListReservationsResponse
+	(*GetReservationRequest)(nil),     // 7: scow.crane_adapter.GetReservationRequest
+	(*GetReservationResponse)(nil),    // 8: scow.crane_adapter.GetReservationResponse
+	(*JobReservations)(nil),           // 9: scow.crane_adapter.JobReservations
+	nil,                               // 10: scow.crane_adapter.JobReservations.ReservationsEntry
+	(*timestamppb.Timestamp)(nil),     // 11: google.protobuf.Timestamp
+}
+var file_adapter_reservation_proto_depIdxs = []int32{
+	11, // 0: scow.crane_adapter.ReservationInfo.start_time:type_name -> google.protobuf.Timestamp
+	11, // 1: scow.crane_adapter.CreateReservationRequest.start_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: scow.crane_adapter.ListReservationsResponse.reservations:type_name -> scow.crane_adapter.ReservationInfo
+	0,  // 3: scow.crane_adapter.GetReservationResponse.reservation:type_name -> scow.crane_adapter.ReservationInfo
+	10, // 4: scow.crane_adapter.JobReservations.reservations:type_name -> scow.crane_adapter.JobReservations.ReservationsEntry
+	1,  // 5: scow.crane_adapter.ReservationService.CreateReservation:input_type -> scow.crane_adapter.CreateReservationRequest
+	3,  // 6: scow.crane_adapter.ReservationService.DeleteReservation:input_type -> scow.crane_adapter.DeleteReservationRequest
+	5,  // 7: scow.crane_adapter.ReservationService.ListReservations:input_type -> scow.crane_adapter.ListReservationsRequest
+	7,  // 8: scow.crane_adapter.ReservationService.GetReservation:input_type -> scow.crane_adapter.GetReservationRequest
+	2,  // 9: scow.crane_adapter.ReservationService.CreateReservation:output_type -> scow.crane_adapter.CreateReservationResponse
+	4,  // 10: scow.crane_adapter.ReservationService.DeleteReservation:output_type -> scow.crane_adapter.DeleteReservationResponse
+	6,  // 11: scow.crane_adapter.ReservationService.ListReservations:output_type -> scow.crane_adapter.ListReservationsResponse
+	8,  // 12: scow.crane_adapter.ReservationService.GetReservation:output_type -> scow.crane_adapter.GetReservationResponse
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_adapter_reservation_proto_init() }
+func file_adapter_reservation_proto_init() {
+	if File_adapter_reservation_proto != nil {
+		return
+	}
+	file_adapter_reservation_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_adapter_reservation_proto_rawDesc), len(file_adapter_reservation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_adapter_reservation_proto_goTypes,
+		DependencyIndexes: file_adapter_reservation_proto_depIdxs,
+		MessageInfos:      file_adapter_reservation_proto_msgTypes,
+	}.Build()
+	File_adapter_reservation_proto = out.File
+	file_adapter_reservation_proto_goTypes = nil
+	file_adapter_reservation_proto_depIdxs = nil
+}
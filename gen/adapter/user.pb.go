@@ -0,0 +1,1966 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: adapter/user.proto
+
+package adapter
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminLevel int32
+
+const (
+	AdminLevel_NONE     AdminLevel = 0
+	AdminLevel_OPERATOR AdminLevel = 1
+	AdminLevel_ADMIN    AdminLevel = 2
+)
+
+// Enum value maps for AdminLevel.
+var (
+	AdminLevel_name = map[int32]string{
+		0: "NONE",
+		1: "OPERATOR",
+		2: "ADMIN",
+	}
+	AdminLevel_value = map[string]int32{
+		"NONE":     0,
+		"OPERATOR": 1,
+		"ADMIN":    2,
+	}
+)
+
+func (x AdminLevel) Enum() *AdminLevel {
+	p := new(AdminLevel)
+	*p = x
+	return p
+}
+
+func (x AdminLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdminLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_adapter_user_proto_enumTypes[0].Descriptor()
+}
+
+func (AdminLevel) Type() protoreflect.EnumType {
+	return &file_adapter_user_proto_enumTypes[0]
+}
+
+func (x AdminLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdminLevel.Descriptor instead.
+func (AdminLevel) EnumDescriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{0}
+}
+
+type SetUserAdminLevelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AdminLevel    AdminLevel             `protobuf:"varint,2,opt,name=admin_level,json=adminLevel,proto3,enum=scow.crane_adapter.AdminLevel" json:"admin_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserAdminLevelRequest) Reset() {
+	*x = SetUserAdminLevelRequest{}
+	mi := &file_adapter_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserAdminLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserAdminLevelRequest) ProtoMessage() {}
+
+func (x *SetUserAdminLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserAdminLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetUserAdminLevelRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetUserAdminLevelRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserAdminLevelRequest) GetAdminLevel() AdminLevel {
+	if x != nil {
+		return x.AdminLevel
+	}
+	return AdminLevel_NONE
+}
+
+type SetUserAdminLevelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserAdminLevelResponse) Reset() {
+	*x = SetUserAdminLevelResponse{}
+	mi := &file_adapter_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserAdminLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserAdminLevelResponse) ProtoMessage() {}
+
+func (x *SetUserAdminLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserAdminLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetUserAdminLevelResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{1}
+}
+
+type AddUserToAccountAsCoordinatorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountName   string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUserToAccountAsCoordinatorRequest) Reset() {
+	*x = AddUserToAccountAsCoordinatorRequest{}
+	mi := &file_adapter_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUserToAccountAsCoordinatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUserToAccountAsCoordinatorRequest) ProtoMessage() {}
+
+func (x *AddUserToAccountAsCoordinatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUserToAccountAsCoordinatorRequest.ProtoReflect.Descriptor instead.
+func (*AddUserToAccountAsCoordinatorRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddUserToAccountAsCoordinatorRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddUserToAccountAsCoordinatorRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type AddUserToAccountAsCoordinatorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUserToAccountAsCoordinatorResponse) Reset() {
+	*x = AddUserToAccountAsCoordinatorResponse{}
+	mi := &file_adapter_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUserToAccountAsCoordinatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUserToAccountAsCoordinatorResponse) ProtoMessage() {}
+
+func (x *AddUserToAccountAsCoordinatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUserToAccountAsCoordinatorResponse.ProtoReflect.Descriptor instead.
+func (*AddUserToAccountAsCoordinatorResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{3}
+}
+
+type ListAccountCoordinatorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNames  []string               `protobuf:"bytes,1,rep,name=account_names,json=accountNames,proto3" json:"account_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountCoordinatorsRequest) Reset() {
+	*x = ListAccountCoordinatorsRequest{}
+	mi := &file_adapter_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountCoordinatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountCoordinatorsRequest) ProtoMessage() {}
+
+func (x *ListAccountCoordinatorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountCoordinatorsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountCoordinatorsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAccountCoordinatorsRequest) GetAccountNames() []string {
+	if x != nil {
+		return x.AccountNames
+	}
+	return nil
+}
+
+type ListAccountCoordinatorsResponse struct {
+	state         protoimpl.MessageState                                 `protogen:"open.v1"`
+	Accounts      []*ListAccountCoordinatorsResponse_AccountCoordinators `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountCoordinatorsResponse) Reset() {
+	*x = ListAccountCoordinatorsResponse{}
+	mi := &file_adapter_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountCoordinatorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountCoordinatorsResponse) ProtoMessage() {}
+
+func (x *ListAccountCoordinatorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountCoordinatorsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountCoordinatorsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAccountCoordinatorsResponse) GetAccounts() []*ListAccountCoordinatorsResponse_AccountCoordinators {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type ListAccountUserRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNames  []string               `protobuf:"bytes,1,rep,name=account_names,json=accountNames,proto3" json:"account_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountUserRolesRequest) Reset() {
+	*x = ListAccountUserRolesRequest{}
+	mi := &file_adapter_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountUserRolesRequest) ProtoMessage() {}
+
+func (x *ListAccountUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListAccountUserRolesRequest) GetAccountNames() []string {
+	if x != nil {
+		return x.AccountNames
+	}
+	return nil
+}
+
+type ListAccountUserRolesResponse struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	Accounts      []*ListAccountUserRolesResponse_AccountRoles `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountUserRolesResponse) Reset() {
+	*x = ListAccountUserRolesResponse{}
+	mi := &file_adapter_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountUserRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountUserRolesResponse) ProtoMessage() {}
+
+func (x *ListAccountUserRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountUserRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountUserRolesResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAccountUserRolesResponse) GetAccounts() []*ListAccountUserRolesResponse_AccountRoles {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+// 用户在账户下的分区和qos设置，字段为空时使用账户的设置
+type UserPartitionQosOverride struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Partitions    []string               `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	QosList       []string               `protobuf:"bytes,2,rep,name=qos_list,json=qosList,proto3" json:"qos_list,omitempty"`
+	DefaultQos    *string                `protobuf:"bytes,3,opt,name=default_qos,json=defaultQos,proto3,oneof" json:"default_qos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPartitionQosOverride) Reset() {
+	*x = UserPartitionQosOverride{}
+	mi := &file_adapter_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPartitionQosOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPartitionQosOverride) ProtoMessage() {}
+
+func (x *UserPartitionQosOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPartitionQosOverride.ProtoReflect.Descriptor instead.
+func (*UserPartitionQosOverride) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UserPartitionQosOverride) GetPartitions() []string {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+func (x *UserPartitionQosOverride) GetQosList() []string {
+	if x != nil {
+		return x.QosList
+	}
+	return nil
+}
+
+func (x *UserPartitionQosOverride) GetDefaultQos() string {
+	if x != nil && x.DefaultQos != nil {
+		return *x.DefaultQos
+	}
+	return ""
+}
+
+type SetUserPartitionQosRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	UserId        string                    `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountName   string                    `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Override      *UserPartitionQosOverride `protobuf:"bytes,3,opt,name=override,proto3" json:"override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserPartitionQosRequest) Reset() {
+	*x = SetUserPartitionQosRequest{}
+	mi := &file_adapter_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserPartitionQosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserPartitionQosRequest) ProtoMessage() {}
+
+func (x *SetUserPartitionQosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserPartitionQosRequest.ProtoReflect.Descriptor instead.
+func (*SetUserPartitionQosRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetUserPartitionQosRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserPartitionQosRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *SetUserPartitionQosRequest) GetOverride() *UserPartitionQosOverride {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+type SetUserPartitionQosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserPartitionQosResponse) Reset() {
+	*x = SetUserPartitionQosResponse{}
+	mi := &file_adapter_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserPartitionQosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserPartitionQosResponse) ProtoMessage() {}
+
+func (x *SetUserPartitionQosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserPartitionQosResponse.ProtoReflect.Descriptor instead.
+func (*SetUserPartitionQosResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{10}
+}
+
+type ClearUserPartitionQosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountName   string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearUserPartitionQosRequest) Reset() {
+	*x = ClearUserPartitionQosRequest{}
+	mi := &file_adapter_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearUserPartitionQosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearUserPartitionQosRequest) ProtoMessage() {}
+
+func (x *ClearUserPartitionQosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearUserPartitionQosRequest.ProtoReflect.Descriptor instead.
+func (*ClearUserPartitionQosRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ClearUserPartitionQosRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClearUserPartitionQosRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type ClearUserPartitionQosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearUserPartitionQosResponse) Reset() {
+	*x = ClearUserPartitionQosResponse{}
+	mi := &file_adapter_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearUserPartitionQosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearUserPartitionQosResponse) ProtoMessage() {}
+
+func (x *ClearUserPartitionQosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearUserPartitionQosResponse.ProtoReflect.Descriptor instead.
+func (*ClearUserPartitionQosResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{12}
+}
+
+type GetUserPartitionQosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountName   string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPartitionQosRequest) Reset() {
+	*x = GetUserPartitionQosRequest{}
+	mi := &file_adapter_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPartitionQosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPartitionQosRequest) ProtoMessage() {}
+
+func (x *GetUserPartitionQosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPartitionQosRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPartitionQosRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUserPartitionQosRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserPartitionQosRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type GetUserPartitionQosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 未单独设置时为空
+	Override                *UserPartitionQosOverride                   `protobuf:"bytes,1,opt,name=override,proto3,oneof" json:"override,omitempty"`
+	AllowedPartitionQosList []*GetUserPartitionQosResponse_PartitionQos `protobuf:"bytes,2,rep,name=allowed_partition_qos_list,json=allowedPartitionQosList,proto3" json:"allowed_partition_qos_list,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetUserPartitionQosResponse) Reset() {
+	*x = GetUserPartitionQosResponse{}
+	mi := &file_adapter_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPartitionQosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPartitionQosResponse) ProtoMessage() {}
+
+func (x *GetUserPartitionQosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPartitionQosResponse.ProtoReflect.Descriptor instead.
+func (*GetUserPartitionQosResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetUserPartitionQosResponse) GetOverride() *UserPartitionQosOverride {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+func (x *GetUserPartitionQosResponse) GetAllowedPartitionQosList() []*GetUserPartitionQosResponse_PartitionQos {
+	if x != nil {
+		return x.AllowedPartitionQosList
+	}
+	return nil
+}
+
+type BlockUserInAccountWithPartitionsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountName       string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	BlockedPartitions []string               `protobuf:"bytes,3,rep,name=blocked_partitions,json=blockedPartitions,proto3" json:"blocked_partitions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BlockUserInAccountWithPartitionsRequest) Reset() {
+	*x = BlockUserInAccountWithPartitionsRequest{}
+	mi := &file_adapter_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserInAccountWithPartitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserInAccountWithPartitionsRequest) ProtoMessage() {}
+
+func (x *BlockUserInAccountWithPartitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserInAccountWithPartitionsRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserInAccountWithPartitionsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BlockUserInAccountWithPartitionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockUserInAccountWithPartitionsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *BlockUserInAccountWithPartitionsRequest) GetBlockedPartitions() []string {
+	if x != nil {
+		return x.BlockedPartitions
+	}
+	return nil
+}
+
+type BlockUserInAccountWithPartitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserInAccountWithPartitionsResponse) Reset() {
+	*x = BlockUserInAccountWithPartitionsResponse{}
+	mi := &file_adapter_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserInAccountWithPartitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserInAccountWithPartitionsResponse) ProtoMessage() {}
+
+func (x *BlockUserInAccountWithPartitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserInAccountWithPartitionsResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserInAccountWithPartitionsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{16}
+}
+
+type UnblockUserInAccountWithPartitionsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountName         string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	UnblockedPartitions []string               `protobuf:"bytes,3,rep,name=unblocked_partitions,json=unblockedPartitions,proto3" json:"unblocked_partitions,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UnblockUserInAccountWithPartitionsRequest) Reset() {
+	*x = UnblockUserInAccountWithPartitionsRequest{}
+	mi := &file_adapter_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserInAccountWithPartitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserInAccountWithPartitionsRequest) ProtoMessage() {}
+
+func (x *UnblockUserInAccountWithPartitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserInAccountWithPartitionsRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserInAccountWithPartitionsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UnblockUserInAccountWithPartitionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnblockUserInAccountWithPartitionsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *UnblockUserInAccountWithPartitionsRequest) GetUnblockedPartitions() []string {
+	if x != nil {
+		return x.UnblockedPartitions
+	}
+	return nil
+}
+
+type UnblockUserInAccountWithPartitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserInAccountWithPartitionsResponse) Reset() {
+	*x = UnblockUserInAccountWithPartitionsResponse{}
+	mi := &file_adapter_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserInAccountWithPartitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserInAccountWithPartitionsResponse) ProtoMessage() {}
+
+func (x *UnblockUserInAccountWithPartitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserInAccountWithPartitionsResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserInAccountWithPartitionsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{18}
+}
+
+type UserStatusInPartition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Partition     string                 `protobuf:"bytes,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	Blocked       bool                   `protobuf:"varint,2,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserStatusInPartition) Reset() {
+	*x = UserStatusInPartition{}
+	mi := &file_adapter_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStatusInPartition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStatusInPartition) ProtoMessage() {}
+
+func (x *UserStatusInPartition) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStatusInPartition.ProtoReflect.Descriptor instead.
+func (*UserStatusInPartition) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UserStatusInPartition) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *UserStatusInPartition) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+type QueryUserInAccountBlockStatusWithPartitionsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountName string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// 为空时查询所有分区
+	Partitions    []string `protobuf:"bytes,3,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryUserInAccountBlockStatusWithPartitionsRequest) Reset() {
+	*x = QueryUserInAccountBlockStatusWithPartitionsRequest{}
+	mi := &file_adapter_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUserInAccountBlockStatusWithPartitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUserInAccountBlockStatusWithPartitionsRequest) ProtoMessage() {}
+
+func (x *QueryUserInAccountBlockStatusWithPartitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUserInAccountBlockStatusWithPartitionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryUserInAccountBlockStatusWithPartitionsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QueryUserInAccountBlockStatusWithPartitionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QueryUserInAccountBlockStatusWithPartitionsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *QueryUserInAccountBlockStatusWithPartitionsRequest) GetPartitions() []string {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+type QueryUserInAccountBlockStatusWithPartitionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户在账户中整体被封锁
+	Blocked            bool                     `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	UserBlockedDetails []*UserStatusInPartition `protobuf:"bytes,2,rep,name=user_blocked_details,json=userBlockedDetails,proto3" json:"user_blocked_details,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *QueryUserInAccountBlockStatusWithPartitionsResponse) Reset() {
+	*x = QueryUserInAccountBlockStatusWithPartitionsResponse{}
+	mi := &file_adapter_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUserInAccountBlockStatusWithPartitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUserInAccountBlockStatusWithPartitionsResponse) ProtoMessage() {}
+
+func (x *QueryUserInAccountBlockStatusWithPartitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUserInAccountBlockStatusWithPartitionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryUserInAccountBlockStatusWithPartitionsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QueryUserInAccountBlockStatusWithPartitionsResponse) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *QueryUserInAccountBlockStatusWithPartitionsResponse) GetUserBlockedDetails() []*UserStatusInPartition {
+	if x != nil {
+		return x.UserBlockedDetails
+	}
+	return nil
+}
+
+type ListUserBlockedDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNames  []string               `protobuf:"bytes,1,rep,name=account_names,json=accountNames,proto3" json:"account_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserBlockedDetailsRequest) Reset() {
+	*x = ListUserBlockedDetailsRequest{}
+	mi := &file_adapter_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserBlockedDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserBlockedDetailsRequest) ProtoMessage() {}
+
+func (x *ListUserBlockedDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserBlockedDetailsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserBlockedDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListUserBlockedDetailsRequest) GetAccountNames() []string {
+	if x != nil {
+		return x.AccountNames
+	}
+	return nil
+}
+
+type ListUserBlockedDetailsResponse struct {
+	state         protoimpl.MessageState                         `protogen:"open.v1"`
+	Accounts      []*ListUserBlockedDetailsResponse_AccountUsers `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserBlockedDetailsResponse) Reset() {
+	*x = ListUserBlockedDetailsResponse{}
+	mi := &file_adapter_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserBlockedDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserBlockedDetailsResponse) ProtoMessage() {}
+
+func (x *ListUserBlockedDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserBlockedDetailsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserBlockedDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListUserBlockedDetailsResponse) GetAccounts() []*ListUserBlockedDetailsResponse_AccountUsers {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type DeleteUserWithOptionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Force  bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// 取消作业后等待作业结束的最长时间(秒)，为0时使用配置文件中job-cancel的设置
+	CancelJobsWaitTimeout uint32 `protobuf:"varint,3,opt,name=cancel_jobs_wait_timeout,json=cancelJobsWaitTimeout,proto3" json:"cancel_jobs_wait_timeout,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DeleteUserWithOptionsRequest) Reset() {
+	*x = DeleteUserWithOptionsRequest{}
+	mi := &file_adapter_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserWithOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserWithOptionsRequest) ProtoMessage() {}
+
+func (x *DeleteUserWithOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserWithOptionsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserWithOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteUserWithOptionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteUserWithOptionsRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteUserWithOptionsRequest) GetCancelJobsWaitTimeout() uint32 {
+	if x != nil {
+		return x.CancelJobsWaitTimeout
+	}
+	return 0
+}
+
+type DeleteUserWithOptionsResponse struct {
+	state           protoimpl.MessageState                         `protogen:"open.v1"`
+	CancelledJobIds []uint32                                       `protobuf:"varint,1,rep,packed,name=cancelled_job_ids,json=cancelledJobIds,proto3" json:"cancelled_job_ids,omitempty"`
+	AccountResults  []*DeleteUserWithOptionsResponse_AccountResult `protobuf:"bytes,2,rep,name=account_results,json=accountResults,proto3" json:"account_results,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteUserWithOptionsResponse) Reset() {
+	*x = DeleteUserWithOptionsResponse{}
+	mi := &file_adapter_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserWithOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserWithOptionsResponse) ProtoMessage() {}
+
+func (x *DeleteUserWithOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserWithOptionsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserWithOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteUserWithOptionsResponse) GetCancelledJobIds() []uint32 {
+	if x != nil {
+		return x.CancelledJobIds
+	}
+	return nil
+}
+
+func (x *DeleteUserWithOptionsResponse) GetAccountResults() []*DeleteUserWithOptionsResponse_AccountResult {
+	if x != nil {
+		return x.AccountResults
+	}
+	return nil
+}
+
+type ListAccountCoordinatorsResponse_AccountCoordinators struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Coordinators  []string               `protobuf:"bytes,2,rep,name=coordinators,proto3" json:"coordinators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountCoordinatorsResponse_AccountCoordinators) Reset() {
+	*x = ListAccountCoordinatorsResponse_AccountCoordinators{}
+	mi := &file_adapter_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountCoordinatorsResponse_AccountCoordinators) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountCoordinatorsResponse_AccountCoordinators) ProtoMessage() {}
+
+func (x *ListAccountCoordinatorsResponse_AccountCoordinators) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountCoordinatorsResponse_AccountCoordinators.ProtoReflect.Descriptor instead.
+func (*ListAccountCoordinatorsResponse_AccountCoordinators) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *ListAccountCoordinatorsResponse_AccountCoordinators) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListAccountCoordinatorsResponse_AccountCoordinators) GetCoordinators() []string {
+	if x != nil {
+		return x.Coordinators
+	}
+	return nil
+}
+
+type ListAccountUserRolesResponse_UserRole struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AdminLevel    AdminLevel             `protobuf:"varint,2,opt,name=admin_level,json=adminLevel,proto3,enum=scow.crane_adapter.AdminLevel" json:"admin_level,omitempty"`
+	Coordinator   bool                   `protobuf:"varint,3,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountUserRolesResponse_UserRole) Reset() {
+	*x = ListAccountUserRolesResponse_UserRole{}
+	mi := &file_adapter_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountUserRolesResponse_UserRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountUserRolesResponse_UserRole) ProtoMessage() {}
+
+func (x *ListAccountUserRolesResponse_UserRole) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountUserRolesResponse_UserRole.ProtoReflect.Descriptor instead.
+func (*ListAccountUserRolesResponse_UserRole) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *ListAccountUserRolesResponse_UserRole) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAccountUserRolesResponse_UserRole) GetAdminLevel() AdminLevel {
+	if x != nil {
+		return x.AdminLevel
+	}
+	return AdminLevel_NONE
+}
+
+func (x *ListAccountUserRolesResponse_UserRole) GetCoordinator() bool {
+	if x != nil {
+		return x.Coordinator
+	}
+	return false
+}
+
+type ListAccountUserRolesResponse_AccountRoles struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	AccountName   string                                   `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Users         []*ListAccountUserRolesResponse_UserRole `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountUserRolesResponse_AccountRoles) Reset() {
+	*x = ListAccountUserRolesResponse_AccountRoles{}
+	mi := &file_adapter_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountUserRolesResponse_AccountRoles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountUserRolesResponse_AccountRoles) ProtoMessage() {}
+
+func (x *ListAccountUserRolesResponse_AccountRoles) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountUserRolesResponse_AccountRoles.ProtoReflect.Descriptor instead.
+func (*ListAccountUserRolesResponse_AccountRoles) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *ListAccountUserRolesResponse_AccountRoles) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListAccountUserRolesResponse_AccountRoles) GetUsers() []*ListAccountUserRolesResponse_UserRole {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetUserPartitionQosResponse_PartitionQos struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Partition     string                 `protobuf:"bytes,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	QosList       []string               `protobuf:"bytes,2,rep,name=qos_list,json=qosList,proto3" json:"qos_list,omitempty"`
+	DefaultQos    string                 `protobuf:"bytes,3,opt,name=default_qos,json=defaultQos,proto3" json:"default_qos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPartitionQosResponse_PartitionQos) Reset() {
+	*x = GetUserPartitionQosResponse_PartitionQos{}
+	mi := &file_adapter_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPartitionQosResponse_PartitionQos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPartitionQosResponse_PartitionQos) ProtoMessage() {}
+
+func (x *GetUserPartitionQosResponse_PartitionQos) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPartitionQosResponse_PartitionQos.ProtoReflect.Descriptor instead.
+func (*GetUserPartitionQosResponse_PartitionQos) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *GetUserPartitionQosResponse_PartitionQos) GetPartition() string {
+	if x != nil {
+		return x.Partition
+	}
+	return ""
+}
+
+func (x *GetUserPartitionQosResponse_PartitionQos) GetQosList() []string {
+	if x != nil {
+		return x.QosList
+	}
+	return nil
+}
+
+func (x *GetUserPartitionQosResponse_PartitionQos) GetDefaultQos() string {
+	if x != nil {
+		return x.DefaultQos
+	}
+	return ""
+}
+
+type ListUserBlockedDetailsResponse_UserBlocked struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	UserId             string                   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserBlockedDetails []*UserStatusInPartition `protobuf:"bytes,2,rep,name=user_blocked_details,json=userBlockedDetails,proto3" json:"user_blocked_details,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListUserBlockedDetailsResponse_UserBlocked) Reset() {
+	*x = ListUserBlockedDetailsResponse_UserBlocked{}
+	mi := &file_adapter_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserBlockedDetailsResponse_UserBlocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserBlockedDetailsResponse_UserBlocked) ProtoMessage() {}
+
+func (x *ListUserBlockedDetailsResponse_UserBlocked) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserBlockedDetailsResponse_UserBlocked.ProtoReflect.Descriptor instead.
+func (*ListUserBlockedDetailsResponse_UserBlocked) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *ListUserBlockedDetailsResponse_UserBlocked) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUserBlockedDetailsResponse_UserBlocked) GetUserBlockedDetails() []*UserStatusInPartition {
+	if x != nil {
+		return x.UserBlockedDetails
+	}
+	return nil
+}
+
+type ListUserBlockedDetailsResponse_AccountUsers struct {
+	state         protoimpl.MessageState                        `protogen:"open.v1"`
+	AccountName   string                                        `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Users         []*ListUserBlockedDetailsResponse_UserBlocked `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserBlockedDetailsResponse_AccountUsers) Reset() {
+	*x = ListUserBlockedDetailsResponse_AccountUsers{}
+	mi := &file_adapter_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserBlockedDetailsResponse_AccountUsers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserBlockedDetailsResponse_AccountUsers) ProtoMessage() {}
+
+func (x *ListUserBlockedDetailsResponse_AccountUsers) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserBlockedDetailsResponse_AccountUsers.ProtoReflect.Descriptor instead.
+func (*ListUserBlockedDetailsResponse_AccountUsers) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{23, 1}
+}
+
+func (x *ListUserBlockedDetailsResponse_AccountUsers) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListUserBlockedDetailsResponse_AccountUsers) GetUsers() []*ListUserBlockedDetailsResponse_UserBlocked {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type DeleteUserWithOptionsResponse_AccountResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserWithOptionsResponse_AccountResult) Reset() {
+	*x = DeleteUserWithOptionsResponse_AccountResult{}
+	mi := &file_adapter_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserWithOptionsResponse_AccountResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserWithOptionsResponse_AccountResult) ProtoMessage() {}
+
+func (x *DeleteUserWithOptionsResponse_AccountResult) ProtoReflect() protoreflect.Message {
+	mi := &file_adapter_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserWithOptionsResponse_AccountResult.ProtoReflect.Descriptor instead.
+func (*DeleteUserWithOptionsResponse_AccountResult) Descriptor() ([]byte, []int) {
+	return file_adapter_user_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *DeleteUserWithOptionsResponse_AccountResult) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *DeleteUserWithOptionsResponse_AccountResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteUserWithOptionsResponse_AccountResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_adapter_user_proto protoreflect.FileDescriptor
+
+const file_adapter_user_proto_rawDesc = "" +
+	"\n" +
+	"\x12adapter/user.proto\x12\x12scow.crane_adapter\"t\n" +
+	"\x18SetUserAdminLevelRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12?\n" +
+	"\vadmin_level\x18\x02 \x01(\x0e2\x1e.scow.crane_adapter.AdminLevelR\n" +
+	"adminLevel\"\x1b\n" +
+	"\x19SetUserAdminLevelResponse\"b\n" +
+	"$AddUserToAccountAsCoordinatorRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\"'\n" +
+	"%AddUserToAccountAsCoordinatorResponse\"E\n" +
+	"\x1eListAccountCoordinatorsRequest\x12#\n" +
+	"\raccount_names\x18\x01 \x03(\tR\faccountNames\"\xe4\x01\n" +
+	"\x1fListAccountCoordinatorsResponse\x12c\n" +
+	"\baccounts\x18\x01 \x03(\v2G.scow.crane_adapter.ListAccountCoordinatorsResponse.AccountCoordinatorsR\baccounts\x1a\\\n" +
+	"\x13AccountCoordinators\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12\"\n" +
+	"\fcoordinators\x18\x02 \x03(\tR\fcoordinators\"B\n" +
+	"\x1bListAccountUserRolesRequest\x12#\n" +
+	"\raccount_names\x18\x01 \x03(\tR\faccountNames\"\x87\x03\n" +
+	"\x1cListAccountUserRolesResponse\x12Y\n" +
+	"\baccounts\x18\x01 \x03(\v2=.scow.crane_adapter.ListAccountUserRolesResponse.AccountRolesR\baccounts\x1a\x86\x01\n" +
+	"\bUserRole\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12?\n" +
+	"\vadmin_level\x18\x02 \x01(\x0e2\x1e.scow.crane_adapter.AdminLevelR\n" +
+	"adminLevel\x12 \n" +
+	"\vcoordinator\x18\x03 \x01(\bR\vcoordinator\x1a\x82\x01\n" +
+	"\fAccountRoles\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12O\n" +
+	"\x05users\x18\x02 \x03(\v29.scow.crane_adapter.ListAccountUserRolesResponse.UserRoleR\x05users\"\x8b\x01\n" +
+	"\x18UserPartitionQosOverride\x12\x1e\n" +
+	"\n" +
+	"partitions\x18\x01 \x03(\tR\n" +
+	"partitions\x12\x19\n" +
+	"\bqos_list\x18\x02 \x03(\tR\aqosList\x12$\n" +
+	"\vdefault_qos\x18\x03 \x01(\tH\x00R\n" +
+	"defaultQos\x88\x01\x01B\x0e\n" +
+	"\f_default_qos\"\xa2\x01\n" +
+	"\x1aSetUserPartitionQosRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12H\n" +
+	"\boverride\x18\x03 \x01(\v2,.scow.crane_adapter.UserPartitionQosOverrideR\boverride\"\x1d\n" +
+	"\x1bSetUserPartitionQosResponse\"Z\n" +
+	"\x1cClearUserPartitionQosRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\"\x1f\n" +
+	"\x1dClearUserPartitionQosResponse\"X\n" +
+	"\x1aGetUserPartitionQosRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\"\xde\x02\n" +
+	"\x1bGetUserPartitionQosResponse\x12M\n" +
+	"\boverride\x18\x01 \x01(\v2,.scow.crane_adapter.UserPartitionQosOverrideH\x00R\boverride\x88\x01\x01\x12y\n" +
+	"\x1aallowed_partition_qos_list\x18\x02 \x03(\v2<.scow.crane_adapter.GetUserPartitionQosResponse.PartitionQosR\x17allowedPartitionQosList\x1ah\n" +
+	"\fPartitionQos\x12\x1c\n" +
+	"\tpartition\x18\x01 \x01(\tR\tpartition\x12\x19\n" +
+	"\bqos_list\x18\x02 \x03(\tR\aqosList\x12\x1f\n" +
+	"\vdefault_qos\x18\x03 \x01(\tR\n" +
+	"defaultQosB\v\n" +
+	"\t_override\"\x94\x01\n" +
+	"'BlockUserInAccountWithPartitionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12-\n" +
+	"\x12blocked_partitions\x18\x03 \x03(\tR\x11blockedPartitions\"*\n" +
+	"(BlockUserInAccountWithPartitionsResponse\"\x9a\x01\n" +
+	")UnblockUserInAccountWithPartitionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x121\n" +
+	"\x14unblocked_partitions\x18\x03 \x03(\tR\x13unblockedPartitions\",\n" +
+	"*UnblockUserInAccountWithPartitionsResponse\"O\n" +
+	"\x15UserStatusInPartition\x12\x1c\n" +
+	"\tpartition\x18\x01 \x01(\tR\tpartition\x12\x18\n" +
+	"\ablocked\x18\x02 \x01(\bR\ablocked\"\x90\x01\n" +
+	"2QueryUserInAccountBlockStatusWithPartitionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12\x1e\n" +
+	"\n" +
+	"partitions\x18\x03 \x03(\tR\n" +
+	"partitions\"\xac\x01\n" +
+	"3QueryUserInAccountBlockStatusWithPartitionsResponse\x12\x18\n" +
+	"\ablocked\x18\x01 \x01(\bR\ablocked\x12[\n" +
+	"\x14user_blocked_details\x18\x02 \x03(\v2).scow.crane_adapter.UserStatusInPartitionR\x12userBlockedDetails\"D\n" +
+	"\x1dListUserBlockedDetailsRequest\x12#\n" +
+	"\raccount_names\x18\x01 \x03(\tR\faccountNames\"\x8d\x03\n" +
+	"\x1eListUserBlockedDetailsResponse\x12[\n" +
+	"\baccounts\x18\x01 \x03(\v2?.scow.crane_adapter.ListUserBlockedDetailsResponse.AccountUsersR\baccounts\x1a\x83\x01\n" +
+	"\vUserBlocked\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12[\n" +
+	"\x14user_blocked_details\x18\x02 \x03(\v2).scow.crane_adapter.UserStatusInPartitionR\x12userBlockedDetails\x1a\x87\x01\n" +
+	"\fAccountUsers\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12T\n" +
+	"\x05users\x18\x02 \x03(\v2>.scow.crane_adapter.ListUserBlockedDetailsResponse.UserBlockedR\x05users\"\x86\x01\n" +
+	"\x1cDeleteUserWithOptionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\x127\n" +
+	"\x18cancel_jobs_wait_timeout\x18\x03 \x01(\rR\x15cancelJobsWaitTimeout\"\x9d\x02\n" +
+	"\x1dDeleteUserWithOptionsResponse\x12*\n" +
+	"\x11cancelled_job_ids\x18\x01 \x03(\rR\x0fcancelledJobIds\x12h\n" +
+	"\x0faccount_results\x18\x02 \x03(\v2?.scow.crane_adapter.DeleteUserWithOptionsResponse.AccountResultR\x0eaccountResults\x1af\n" +
+	"\rAccountResult\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage*/\n" +
+	"\n" +
+	"AdminLevel\x12\b\n" +
+	"\x04NONE\x10\x00\x12\f\n" +
+	"\bOPERATOR\x10\x01\x12\t\n" +
+	"\x05ADMIN\x10\x022\x8d\r\n" +
+	"\x0eUserExtService\x12p\n" +
+	"\x11SetUserAdminLevel\x12,.scow.crane_adapter.SetUserAdminLevelRequest\x1a-.scow.crane_adapter.SetUserAdminLevelResponse\x12\x94\x01\n" +
+	"\x1dAddUserToAccountAsCoordinator\x128.scow.crane_adapter.AddUserToAccountAsCoordinatorRequest\x1a9.scow.crane_adapter.AddUserToAccountAsCoordinatorResponse\x12\x82\x01\n" +
+	"\x17ListAccountCoordinators\x122.scow.crane_adapter.ListAccountCoordinatorsRequest\x1a3.scow.crane_adapter.ListAccountCoordinatorsResponse\x12y\n" +
+	"\x14ListAccountUserRoles\x12/.scow.crane_adapter.ListAccountUserRolesRequest\x1a0.scow.crane_adapter.ListAccountUserRolesResponse\x12v\n" +
+	"\x13SetUserPartitionQos\x12..scow.crane_adapter.SetUserPartitionQosRequest\x1a/.scow.crane_adapter.SetUserPartitionQosResponse\x12|\n" +
+	"\x15ClearUserPartitionQos\x120.scow.crane_adapter.ClearUserPartitionQosRequest\x1a1.scow.crane_adapter.ClearUserPartitionQosResponse\x12v\n" +
+	"\x13GetUserPartitionQos\x12..scow.crane_adapter.GetUserPartitionQosRequest\x1a/.scow.crane_adapter.GetUserPartitionQosResponse\x12\x9d\x01\n" +
+	" BlockUserInAccountWithPartitions\x12;.scow.crane_adapter.BlockUserInAccountWithPartitionsRequest\x1a<.scow.crane_adapter.BlockUserInAccountWithPartitionsResponse\x12\xa3\x01\n" +
+	"\"UnblockUserInAccountWithPartitions\x12=.scow.crane_adapter.UnblockUserInAccountWithPartitionsRequest\x1a>.scow.crane_adapter.UnblockUserInAccountWithPartitionsResponse\x12\xbe\x01\n" +
+	"+QueryUserInAccountBlockStatusWithPartitions\x12F.scow.crane_adapter.QueryUserInAccountBlockStatusWithPartitionsRequest\x1aG.scow.crane_adapter.QueryUserInAccountBlockStatusWithPartitionsResponse\x12\x7f\n" +
+	"\x16ListUserBlockedDetails\x121.scow.crane_adapter.ListUserBlockedDetailsRequest\x1a2.scow.crane_adapter.ListUserBlockedDetailsResponse\x12|\n" +
+	"\x15DeleteUserWithOptions\x120.scow.crane_adapter.DeleteUserWithOptionsRequest\x1a1.scow.crane_adapter.DeleteUserWithOptionsResponseB Z\x1escow-crane-adapter/gen/adapterb\x06proto3"
+
+var (
+	file_adapter_user_proto_rawDescOnce sync.Once
+	file_adapter_user_proto_rawDescData []byte
+)
+
+func file_adapter_user_proto_rawDescGZIP() []byte {
+	file_adapter_user_proto_rawDescOnce.Do(func() {
+		file_adapter_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_adapter_user_proto_rawDesc), len(file_adapter_user_proto_rawDesc)))
+	})
+	return file_adapter_user_proto_rawDescData
+}
+
+var file_adapter_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_adapter_user_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_adapter_user_proto_goTypes = []any{
+	(AdminLevel)(0),                                             // 0: scow.crane_adapter.AdminLevel
+	(*SetUserAdminLevelRequest)(nil),                            // 1: scow.crane_adapter.SetUserAdminLevelRequest
+	(*SetUserAdminLevelResponse)(nil),                           // 2: scow.crane_adapter.SetUserAdminLevelResponse
+	(*AddUserToAccountAsCoordinatorRequest)(nil),                // 3: scow.crane_adapter.AddUserToAccountAsCoordinatorRequest
+	(*AddUserToAccountAsCoordinatorResponse)(nil),               // 4: scow.crane_adapter.AddUserToAccountAsCoordinatorResponse
+	(*ListAccountCoordinatorsRequest)(nil),                      // 5: scow.crane_adapter.ListAccountCoordinatorsRequest
+	(*ListAccountCoordinatorsResponse)(nil),                     // 6: scow.crane_adapter.ListAccountCoordinatorsResponse
+	(*ListAccountUserRolesRequest)(nil),                         // 7: scow.crane_adapter.ListAccountUserRolesRequest
+	(*ListAccountUserRolesResponse)(nil),                        // 8: scow.crane_adapter.ListAccountUserRolesResponse
+	(*UserPartitionQosOverride)(nil),                            // 9: scow.crane_adapter.UserPartitionQosOverride
+	(*SetUserPartitionQosRequest)(nil),                          // 10: scow.crane_adapter.SetUserPartitionQosRequest
+	(*SetUserPartitionQosResponse)(nil),                         // 11: scow.crane_adapter.SetUserPartitionQosResponse
+	(*ClearUserPartitionQosRequest)(nil),                        // 12: scow.crane_adapter.ClearUserPartitionQosRequest
+	(*ClearUserPartitionQosResponse)(nil),                       // 13: scow.crane_adapter.ClearUserPartitionQosResponse
+	(*GetUserPartitionQosRequest)(nil),                          // 14: scow.crane_adapter.GetUserPartitionQosRequest
+	(*GetUserPartitionQosResponse)(nil),                         // 15: scow.crane_adapter.GetUserPartitionQosResponse
+	(*BlockUserInAccountWithPartitionsRequest)(nil),             // 16: scow.crane_adapter.BlockUserInAccountWithPartitionsRequest
+	(*BlockUserInAccountWithPartitionsResponse)(nil),            // 17: scow.crane_adapter.BlockUserInAccountWithPartitionsResponse
+	(*UnblockUserInAccountWithPartitionsRequest)(nil),           // 18: scow.crane_adapter.UnblockUserInAccountWithPartitionsRequest
+	(*UnblockUserInAccountWithPartitionsResponse)(nil),          // 19: scow.crane_adapter.UnblockUserInAccountWithPartitionsResponse
+	(*UserStatusInPartition)(nil),                               // 20: scow.crane_adapter.UserStatusInPartition
+	(*QueryUserInAccountBlockStatusWithPartitionsRequest)(nil),  // 21: scow.crane_adapter.QueryUserInAccountBlockStatusWithPartitionsRequest
+	(*QueryUserInAccountBlockStatusWithPartitionsResponse)(nil), // 22: scow.crane_adapter.QueryUserInAccountBlockStatusWithPartitionsResponse
+	(*ListUserBlockedDetailsRequest)(nil),                       // 23: scow.crane_adapter.ListUserBlockedDetailsRequest
+	(*ListUserBlockedDetailsResponse)(nil),                      // 24: scow.crane_adapter.ListUserBlockedDetailsResponse
+	(*DeleteUserWithOptionsRequest)(nil),                        // 25: scow.crane_adapter.DeleteUserWithOptionsRequest
+	(*DeleteUserWithOptionsResponse)(nil),                       // 26: scow.crane_adapter.DeleteUserWithOptionsResponse
+	(*ListAccountCoordinatorsResponse_AccountCoordinators)(nil), // 27: scow.crane_adapter.ListAccountCoordinatorsResponse.AccountCoordinators
+	(*ListAccountUserRolesResponse_UserRole)(nil),               // 28: scow.crane_adapter.ListAccountUserRolesResponse.UserRole
+	(*ListAccountUserRolesResponse_AccountRoles)(nil),           // 29: scow.crane_adapter.ListAccountUserRolesResponse.AccountRoles
+	(*GetUserPartitionQosResponse_PartitionQos)(nil),            // 30: scow.crane_adapter.GetUserPartitionQosResponse.PartitionQos
+	(*ListUserBlockedDetailsResponse_UserBlocked)(nil),          // 31: scow.crane_adapter.ListUserBlockedDetailsResponse.UserBlocked
+	(*ListUserBlockedDetailsResponse_AccountUsers)(nil),         // 32: scow.crane_adapter.ListUserBlockedDetailsResponse.AccountUsers
+	(*DeleteUserWithOptionsResponse_AccountResult)(nil),         // 33: scow.crane_adapter.DeleteUserWithOptionsResponse.AccountResult
+}
+var file_adapter_user_proto_depIdxs = []int32{
+	0,  // 0: scow.crane_adapter.SetUserAdminLevelRequest.admin_level:type_name -> scow.crane_adapter.AdminLevel
+	27, // 1: scow.crane_adapter.ListAccountCoordinatorsResponse.accounts:type_name -> scow.crane_adapter.ListAccountCoordinatorsResponse.AccountCoordinators
+	29, // 2: scow.crane_adapter.ListAccountUserRolesResponse.accounts:type_name -> scow.crane_adapter.ListAccountUserRolesResponse.AccountRoles
+	9,  // 3: scow.crane_adapter.SetUserPartitionQosRequest.override:type_name -> scow.crane_adapter.UserPartitionQosOverride
+	9,  // 4: scow.crane_adapter.GetUserPartitionQosResponse.override:type_name -> scow.crane_adapter.UserPartitionQosOverride
+	30, // 5: scow.crane_adapter.GetUserPartitionQosResponse.allowed_partition_qos_list:type_name -> scow.crane_adapter.GetUserPartitionQosResponse.PartitionQos
+	20, // 6: scow.crane_adapter.QueryUserInAccountBlockStatusWithPartitionsResponse.user_blocked_details:type_name -> scow.crane_adapter.UserStatusInPartition
+	32, // 7: scow.crane_adapter.ListUserBlockedDetailsResponse.accounts:type_name -> scow.crane_adapter.ListUserBlockedDetailsResponse.AccountUsers
+	33, // 8: scow.crane_adapter.DeleteUserWithOptionsResponse.account_results:type_name -> scow.crane_adapter.DeleteUserWithOptionsResponse.AccountResult
+	0,  // 9: scow.crane_adapter.ListAccountUserRolesResponse.UserRole.admin_level:type_name -> scow.crane_adapter.AdminLevel
+	28, // 10: scow.crane_adapter.ListAccountUserRolesResponse.AccountRoles.users:type_name -> scow.crane_adapter.ListAccountUserRolesResponse.UserRole
+	20, // 11: scow.crane_adapter.ListUserBlockedDetailsResponse.UserBlocked.user_blocked_details:type_name -> scow.crane_adapter.UserStatusInPartition
+	31, // 12: scow.crane_adapter.ListUserBlockedDetailsResponse.AccountUsers.users:type_name -> scow.crane_adapter.ListUserBlockedDetailsResponse.UserBlocked
+	1,  // 13: scow.crane_adapter.UserExtService.SetUserAdminLevel:input_type -> scow.crane_adapter.SetUserAdminLevelRequest
+	3,  // 14: scow.crane_adapter.UserExtService.AddUserToAccountAsCoordinator:input_type -> scow.crane_adapter.AddUserToAccountAsCoordinatorRequest
+	5,  // 15: scow.crane_adapter.UserExtService.ListAccountCoordinators:input_type -> scow.crane_adapter.ListAccountCoordinatorsRequest
+	7,  // 16: scow.crane_adapter.UserExtService.ListAccountUserRoles:input_type -> scow.crane_adapter.ListAccountUserRolesRequest
+	10, // 17: scow.crane_adapter.UserExtService.SetUserPartitionQos:input_type -> scow.crane_adapter.SetUserPartitionQosRequest
+	12, // 18: scow.crane_adapter.UserExtService.ClearUserPartitionQos:input_type -> scow.crane_adapter.ClearUserPartitionQosRequest
+	14, // 19: scow.crane_adapter.UserExtService.GetUserPartitionQos:input_type -> scow.crane_adapter.GetUserPartitionQosRequest
+	16, // 20: scow.crane_adapter.UserExtService.BlockUserInAccountWithPartitions:input_type -> scow.crane_adapter.BlockUserInAccountWithPartitionsRequest
+	18, // 21: scow.crane_adapter.UserExtService.UnblockUserInAccountWithPartitions:input_type -> scow.crane_adapter.UnblockUserInAccountWithPartitionsRequest
+	21, // 22: scow.crane_adapter.UserExtService.QueryUserInAccountBlockStatusWithPartitions:input_type -> scow.crane_adapter.QueryUserInAccountBlockStatusWithPartitionsRequest
+	23, // 23: scow.crane_adapter.UserExtService.ListUserBlockedDetails:input_type -> scow.crane_adapter.ListUserBlockedDetailsRequest
+	25, // 24: scow.crane_adapter.UserExtService.DeleteUserWithOptions:input_type -> scow.crane_adapter.DeleteUserWithOptionsRequest
+	2,  // 25: scow.crane_adapter.UserExtService.SetUserAdminLevel:output_type -> scow.crane_adapter.SetUserAdminLevelResponse
+	4,  // 26: scow.crane_adapter.UserExtService.AddUserToAccountAsCoordinator:output_type -> scow.crane_adapter.AddUserToAccountAsCoordinatorResponse
+	6,  // 27: scow.crane_adapter.UserExtService.ListAccountCoordinators:output_type -> scow.crane_adapter.ListAccountCoordinatorsResponse
+	8,  // 28: scow.crane_adapter.UserExtService.ListAccountUserRoles:output_type -> scow.crane_adapter.ListAccountUserRolesResponse
+	11, // 29: scow.crane_adapter.UserExtService.SetUserPartitionQos:output_type -> scow.crane_adapter.SetUserPartitionQosResponse
+	13, // 30: scow.crane_adapter.UserExtService.ClearUserPartitionQos:output_type -> scow.crane_adapter.ClearUserPartitionQosResponse
+	15, // 31: scow.crane_adapter.UserExtService.GetUserPartitionQos:output_type -> scow.crane_adapter.GetUserPartitionQosResponse
+	17, // 32: scow.crane_adapter.UserExtService.BlockUserInAccountWithPartitions:output_type -> scow.crane_adapter.BlockUserInAccountWithPartitionsResponse
+	19, // 33: scow.crane_adapter.UserExtService.UnblockUserInAccountWithPartitions:output_type -> scow.crane_adapter.UnblockUserInAccountWithPartitionsResponse
+	22, // 34: scow.crane_adapter.UserExtService.QueryUserInAccountBlockStatusWithPartitions:output_type -> scow.crane_adapter.QueryUserInAccountBlockStatusWithPartitionsResponse
+	24, // 35: scow.crane_adapter.UserExtService.ListUserBlockedDetails:output_type -> scow.crane_adapter.ListUserBlockedDetailsResponse
+	26, // 36: scow.crane_adapter.UserExtService.DeleteUserWithOptions:output_type -> scow.crane_adapter.DeleteUserWithOptionsResponse
+	25, // [25:37] is the sub-list for method output_type
+	13, // [13:25] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_adapter_user_proto_init() }
+func file_adapter_user_proto_init() {
+	if File_adapter_user_proto != nil {
+		return
+	}
+	file_adapter_user_proto_msgTypes[8].OneofWrappers = []any{}
+	file_adapter_user_proto_msgTypes[14].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_adapter_user_proto_rawDesc), len(file_adapter_user_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   33,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_adapter_user_proto_goTypes,
+		DependencyIndexes: file_adapter_user_proto_depIdxs,
+		EnumInfos:         file_adapter_user_proto_enumTypes,
+		MessageInfos:      file_adapter_user_proto_msgTypes,
+	}.Build()
+	File_adapter_user_proto = out.File
+	file_adapter_user_proto_goTypes = nil
+	file_adapter_user_proto_depIdxs = nil
+}
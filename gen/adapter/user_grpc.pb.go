@@ -0,0 +1,573 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: adapter/user.proto
+
+package adapter
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UserExtService_SetUserAdminLevel_FullMethodName                           = "/scow.crane_adapter.UserExtService/SetUserAdminLevel"
+	UserExtService_AddUserToAccountAsCoordinator_FullMethodName               = "/scow.crane_adapter.UserExtService/AddUserToAccountAsCoordinator"
+	UserExtService_ListAccountCoordinators_FullMethodName                     = "/scow.crane_adapter.UserExtService/ListAccountCoordinators"
+	UserExtService_ListAccountUserRoles_FullMethodName                        = "/scow.crane_adapter.UserExtService/ListAccountUserRoles"
+	UserExtService_SetUserPartitionQos_FullMethodName                         = "/scow.crane_adapter.UserExtService/SetUserPartitionQos"
+	UserExtService_ClearUserPartitionQos_FullMethodName                       = "/scow.crane_adapter.UserExtService/ClearUserPartitionQos"
+	UserExtService_GetUserPartitionQos_FullMethodName                         = "/scow.crane_adapter.UserExtService/GetUserPartitionQos"
+	UserExtService_BlockUserInAccountWithPartitions_FullMethodName            = "/scow.crane_adapter.UserExtService/BlockUserInAccountWithPartitions"
+	UserExtService_UnblockUserInAccountWithPartitions_FullMethodName          = "/scow.crane_adapter.UserExtService/UnblockUserInAccountWithPartitions"
+	UserExtService_QueryUserInAccountBlockStatusWithPartitions_FullMethodName = "/scow.crane_adapter.UserExtService/QueryUserInAccountBlockStatusWithPartitions"
+	UserExtService_ListUserBlockedDetails_FullMethodName                      = "/scow.crane_adapter.UserExtService/ListUserBlockedDetails"
+	UserExtService_DeleteUserWithOptions_FullMethodName                       = "/scow.crane_adapter.UserExtService/DeleteUserWithOptions"
+)
+
+// UserExtServiceClient is the client API for UserExtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 适配器在scow调度器接口之外提供的用户扩展接口
+type UserExtServiceClient interface {
+	// 设置用户的管理级别，鹤思中管理级别对用户的所有账户生效
+	SetUserAdminLevel(ctx context.Context, in *SetUserAdminLevelRequest, opts ...grpc.CallOption) (*SetUserAdminLevelResponse, error)
+	// 将用户作为协调者(账户管理员)添加到账户中，协调者可以管理账户下其他用户的作业，其他同AddUserToAccount
+	// 鹤思只在添加用户时接受协调者身份，不支持修改已在账户中的用户的协调者身份：
+	// 用户已是账户的协调者时直接返回，已在账户中但不是协调者时返回FAILED_PRECONDITION，需要先将用户移出账户
+	// 取消协调者身份同样需要将用户移出账户后用AddUserToAccount重新添加
+	AddUserToAccountAsCoordinator(ctx context.Context, in *AddUserToAccountAsCoordinatorRequest, opts ...grpc.CallOption) (*AddUserToAccountAsCoordinatorResponse, error)
+	// 查询账户的协调者，account_names为空时查询所有账户
+	ListAccountCoordinators(ctx context.Context, in *ListAccountCoordinatorsRequest, opts ...grpc.CallOption) (*ListAccountCoordinatorsResponse, error)
+	// 查询账户中用户的管理级别和协调者身份，account_names为空时查询所有未归档的账户
+	ListAccountUserRoles(ctx context.Context, in *ListAccountUserRolesRequest, opts ...grpc.CallOption) (*ListAccountUserRolesResponse, error)
+	// 单独设置用户在账户下可用的分区和qos，不影响账户及其他用户，账户按分区封锁解封后设置仍然保留
+	SetUserPartitionQos(ctx context.Context, in *SetUserPartitionQosRequest, opts ...grpc.CallOption) (*SetUserPartitionQosResponse, error)
+	// 清除用户的单独设置，恢复为账户的分区和qos
+	ClearUserPartitionQos(ctx context.Context, in *ClearUserPartitionQosRequest, opts ...grpc.CallOption) (*ClearUserPartitionQosResponse, error)
+	// 查询用户的单独设置以及当前实际可用的分区和qos
+	GetUserPartitionQos(ctx context.Context, in *GetUserPartitionQosRequest, opts ...grpc.CallOption) (*GetUserPartitionQosResponse, error)
+	// 在分区上封锁账户中的用户，从用户的可用分区中删除这些分区
+	BlockUserInAccountWithPartitions(ctx context.Context, in *BlockUserInAccountWithPartitionsRequest, opts ...grpc.CallOption) (*BlockUserInAccountWithPartitionsResponse, error)
+	// 在分区上解封账户中的用户，只恢复账户当前可用的分区
+	UnblockUserInAccountWithPartitions(ctx context.Context, in *UnblockUserInAccountWithPartitionsRequest, opts ...grpc.CallOption) (*UnblockUserInAccountWithPartitionsResponse, error)
+	// 查询用户在账户中各分区的封锁状态
+	QueryUserInAccountBlockStatusWithPartitions(ctx context.Context, in *QueryUserInAccountBlockStatusWithPartitionsRequest, opts ...grpc.CallOption) (*QueryUserInAccountBlockStatusWithPartitionsResponse, error)
+	// 批量查询账户中用户在各分区的封锁状态，account_names为空时查询所有未归档的账户
+	ListUserBlockedDetails(ctx context.Context, in *ListUserBlockedDetailsRequest, opts ...grpc.CallOption) (*ListUserBlockedDetailsResponse, error)
+	// 删除用户，用户有排队或运行中的作业时返回FAILED_PRECONDITION，metadata的job_ids中为这些作业的id
+	// force为true时先以适配器的身份取消这些作业，等待结束后将用户从所有账户中移除
+	DeleteUserWithOptions(ctx context.Context, in *DeleteUserWithOptionsRequest, opts ...grpc.CallOption) (*DeleteUserWithOptionsResponse, error)
+}
+
+type userExtServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserExtServiceClient(cc grpc.ClientConnInterface) UserExtServiceClient {
+	return &userExtServiceClient{cc}
+}
+
+func (c *userExtServiceClient) SetUserAdminLevel(ctx context.Context, in *SetUserAdminLevelRequest, opts ...grpc.CallOption) (*SetUserAdminLevelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserAdminLevelResponse)
+	err := c.cc.Invoke(ctx, UserExtService_SetUserAdminLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtServiceClient) AddUserToAccountAsCoordinator(ctx context.Context, in *AddUserToAccountAsCoordinatorRequest, opts ...grpc.CallOption) (*AddUserToAccountAsCoordinatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddUserToAccountAsCoordinatorResponse)
+	err := c.cc.Invoke(ctx, UserExtService_AddUserToAccountAsCoordinator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtServiceClient) ListAccountCoordinators(ctx context.Context, in *ListAccountCoordinatorsRequest, opts ...grpc.CallOption) (*ListAccountCoordinatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountCoordinatorsResponse)
+	err := c.cc.Invoke(ctx, UserExtService_ListAccountCoordinators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtServiceClient) ListAccountUserRoles(ctx context.Context, in *ListAccountUserRolesRequest, opts ...grpc.CallOption) (*ListAccountUserRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountUserRolesResponse)
+	err := c.cc.Invoke(ctx, UserExtService_ListAccountUserRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtServiceClient) SetUserPartitionQos(ctx context.Context, in *SetUserPartitionQosRequest, opts ...grpc.CallOption) (*SetUserPartitionQosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserPartitionQosResponse)
+	err := c.cc.Invoke(ctx, UserExtService_SetUserPartitionQos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtServiceClient) ClearUserPartitionQos(ctx context.Context, in *ClearUserPartitionQosRequest, opts ...grpc.CallOption) (*ClearUserPartitionQosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearUserPartitionQosResponse)
+	err := c.cc.Invoke(ctx, UserExtService_ClearUserPartitionQos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtServiceClient) GetUserPartitionQos(ctx context.Context, in *GetUserPartitionQosRequest, opts ...grpc.CallOption) (*GetUserPartitionQosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserPartitionQosResponse)
+	err := c.cc.Invoke(ctx, UserExtService_GetUserPartitionQos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtServiceClient) BlockUserInAccountWithPartitions(ctx context.Context, in *BlockUserInAccountWithPartitionsRequest, opts ...grpc.CallOption) (*BlockUserInAccountWithPartitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserInAccountWithPartitionsResponse)
+	err := c.cc.Invoke(ctx, UserExtService_BlockUserInAccountWithPartitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtServiceClient) UnblockUserInAccountWithPartitions(ctx context.Context, in *UnblockUserInAccountWithPartitionsRequest, opts ...grpc.CallOption) (*UnblockUserInAccountWithPartitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockUserInAccountWithPartitionsResponse)
+	err := c.cc.Invoke(ctx, UserExtService_UnblockUserInAccountWithPartitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtServiceClient) QueryUserInAccountBlockStatusWithPartitions(ctx context.Context, in *QueryUserInAccountBlockStatusWithPartitionsRequest, opts ...grpc.CallOption) (*QueryUserInAccountBlockStatusWithPartitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUserInAccountBlockStatusWithPartitionsResponse)
+	err := c.cc.Invoke(ctx, UserExtService_QueryUserInAccountBlockStatusWithPartitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtServiceClient) ListUserBlockedDetails(ctx context.Context, in *ListUserBlockedDetailsRequest, opts ...grpc.CallOption) (*ListUserBlockedDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserBlockedDetailsResponse)
+	err := c.cc.Invoke(ctx, UserExtService_ListUserBlockedDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExtServiceClient) DeleteUserWithOptions(ctx context.Context, in *DeleteUserWithOptionsRequest, opts ...grpc.CallOption) (*DeleteUserWithOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserWithOptionsResponse)
+	err := c.cc.Invoke(ctx, UserExtService_DeleteUserWithOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserExtServiceServer is the server API for UserExtService service.
+// All implementations should embed UnimplementedUserExtServiceServer
+// for forward compatibility.
+//
+// 适配器在scow调度器接口之外提供的用户扩展接口
+type UserExtServiceServer interface {
+	// 设置用户的管理级别，鹤思中管理级别对用户的所有账户生效
+	SetUserAdminLevel(context.Context, *SetUserAdminLevelRequest) (*SetUserAdminLevelResponse, error)
+	// 将用户作为协调者(账户管理员)添加到账户中，协调者可以管理账户下其他用户的作业，其他同AddUserToAccount
+	// 鹤思只在添加用户时接受协调者身份，不支持修改已在账户中的用户的协调者身份：
+	// 用户已是账户的协调者时直接返回，已在账户中但不是协调者时返回FAILED_PRECONDITION，需要先将用户移出账户
+	// 取消协调者身份同样需要将用户移出账户后用AddUserToAccount重新添加
+	AddUserToAccountAsCoordinator(context.Context, *AddUserToAccountAsCoordinatorRequest) (*AddUserToAccountAsCoordinatorResponse, error)
+	// 查询账户的协调者，account_names为空时查询所有账户
+	ListAccountCoordinators(context.Context, *ListAccountCoordinatorsRequest) (*ListAccountCoordinatorsResponse, error)
+	// 查询账户中用户的管理级别和协调者身份，account_names为空时查询所有未归档的账户
+	ListAccountUserRoles(context.Context, *ListAccountUserRolesRequest) (*ListAccountUserRolesResponse, error)
+	// 单独设置用户在账户下可用的分区和qos，不影响账户及其他用户，账户按分区封锁解封后设置仍然保留
+	SetUserPartitionQos(context.Context, *SetUserPartitionQosRequest) (*SetUserPartitionQosResponse, error)
+	// 清除用户的单独设置，恢复为账户的分区和qos
+	ClearUserPartitionQos(context.Context, *ClearUserPartitionQosRequest) (*ClearUserPartitionQosResponse, error)
+	// 查询用户的单独设置以及当前实际可用的分区和qos
+	GetUserPartitionQos(context.Context, *GetUserPartitionQosRequest) (*GetUserPartitionQosResponse, error)
+	// 在分区上封锁账户中的用户，从用户的可用分区中删除这些分区
+	BlockUserInAccountWithPartitions(context.Context, *BlockUserInAccountWithPartitionsRequest) (*BlockUserInAccountWithPartitionsResponse, error)
+	// 在分区上解封账户中的用户，只恢复账户当前可用的分区
+	UnblockUserInAccountWithPartitions(context.Context, *UnblockUserInAccountWithPartitionsRequest) (*UnblockUserInAccountWithPartitionsResponse, error)
+	// 查询用户在账户中各分区的封锁状态
+	QueryUserInAccountBlockStatusWithPartitions(context.Context, *QueryUserInAccountBlockStatusWithPartitionsRequest) (*QueryUserInAccountBlockStatusWithPartitionsResponse, error)
+	// 批量查询账户中用户在各分区的封锁状态，account_names为空时查询所有未归档的账户
+	ListUserBlockedDetails(context.Context, *ListUserBlockedDetailsRequest) (*ListUserBlockedDetailsResponse, error)
+	// 删除用户，用户有排队或运行中的作业时返回FAILED_PRECONDITION，metadata的job_ids中为这些作业的id
+	// force为true时先以适配器的身份取消这些作业，等待结束后将用户从所有账户中移除
+	DeleteUserWithOptions(context.Context, *DeleteUserWithOptionsRequest) (*DeleteUserWithOptionsResponse, error)
+}
+
+// UnimplementedUserExtServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserExtServiceServer struct{}
+
+func (UnimplementedUserExtServiceServer) SetUserAdminLevel(context.Context, *SetUserAdminLevelRequest) (*SetUserAdminLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserAdminLevel not implemented")
+}
+func (UnimplementedUserExtServiceServer) AddUserToAccountAsCoordinator(context.Context, *AddUserToAccountAsCoordinatorRequest) (*AddUserToAccountAsCoordinatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserToAccountAsCoordinator not implemented")
+}
+func (UnimplementedUserExtServiceServer) ListAccountCoordinators(context.Context, *ListAccountCoordinatorsRequest) (*ListAccountCoordinatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountCoordinators not implemented")
+}
+func (UnimplementedUserExtServiceServer) ListAccountUserRoles(context.Context, *ListAccountUserRolesRequest) (*ListAccountUserRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountUserRoles not implemented")
+}
+func (UnimplementedUserExtServiceServer) SetUserPartitionQos(context.Context, *SetUserPartitionQosRequest) (*SetUserPartitionQosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserPartitionQos not implemented")
+}
+func (UnimplementedUserExtServiceServer) ClearUserPartitionQos(context.Context, *ClearUserPartitionQosRequest) (*ClearUserPartitionQosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearUserPartitionQos not implemented")
+}
+func (UnimplementedUserExtServiceServer) GetUserPartitionQos(context.Context, *GetUserPartitionQosRequest) (*GetUserPartitionQosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPartitionQos not implemented")
+}
+func (UnimplementedUserExtServiceServer) BlockUserInAccountWithPartitions(context.Context, *BlockUserInAccountWithPartitionsRequest) (*BlockUserInAccountWithPartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUserInAccountWithPartitions not implemented")
+}
+func (UnimplementedUserExtServiceServer) UnblockUserInAccountWithPartitions(context.Context, *UnblockUserInAccountWithPartitionsRequest) (*UnblockUserInAccountWithPartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUserInAccountWithPartitions not implemented")
+}
+func (UnimplementedUserExtServiceServer) QueryUserInAccountBlockStatusWithPartitions(context.Context, *QueryUserInAccountBlockStatusWithPartitionsRequest) (*QueryUserInAccountBlockStatusWithPartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUserInAccountBlockStatusWithPartitions not implemented")
+}
+func (UnimplementedUserExtServiceServer) ListUserBlockedDetails(context.Context, *ListUserBlockedDetailsRequest) (*ListUserBlockedDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserBlockedDetails not implemented")
+}
+func (UnimplementedUserExtServiceServer) DeleteUserWithOptions(context.Context, *DeleteUserWithOptionsRequest) (*DeleteUserWithOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserWithOptions not implemented")
+}
+func (UnimplementedUserExtServiceServer) testEmbeddedByValue() {}
+
+// UnsafeUserExtServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserExtServiceServer will
+// result in compilation errors.
+type UnsafeUserExtServiceServer interface {
+	mustEmbedUnimplementedUserExtServiceServer()
+}
+
+func RegisterUserExtServiceServer(s grpc.ServiceRegistrar, srv UserExtServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserExtServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserExtService_ServiceDesc, srv)
+}
+
+func _UserExtService_SetUserAdminLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserAdminLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServiceServer).SetUserAdminLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExtService_SetUserAdminLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServiceServer).SetUserAdminLevel(ctx, req.(*SetUserAdminLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExtService_AddUserToAccountAsCoordinator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserToAccountAsCoordinatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServiceServer).AddUserToAccountAsCoordinator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExtService_AddUserToAccountAsCoordinator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServiceServer).AddUserToAccountAsCoordinator(ctx, req.(*AddUserToAccountAsCoordinatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExtService_ListAccountCoordinators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountCoordinatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServiceServer).ListAccountCoordinators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExtService_ListAccountCoordinators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServiceServer).ListAccountCoordinators(ctx, req.(*ListAccountCoordinatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExtService_ListAccountUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServiceServer).ListAccountUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExtService_ListAccountUserRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServiceServer).ListAccountUserRoles(ctx, req.(*ListAccountUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExtService_SetUserPartitionQos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserPartitionQosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServiceServer).SetUserPartitionQos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExtService_SetUserPartitionQos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServiceServer).SetUserPartitionQos(ctx, req.(*SetUserPartitionQosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExtService_ClearUserPartitionQos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearUserPartitionQosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServiceServer).ClearUserPartitionQos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExtService_ClearUserPartitionQos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServiceServer).ClearUserPartitionQos(ctx, req.(*ClearUserPartitionQosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExtService_GetUserPartitionQos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPartitionQosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServiceServer).GetUserPartitionQos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExtService_GetUserPartitionQos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServiceServer).GetUserPartitionQos(ctx, req.(*GetUserPartitionQosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExtService_BlockUserInAccountWithPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserInAccountWithPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServiceServer).BlockUserInAccountWithPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExtService_BlockUserInAccountWithPartitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServiceServer).BlockUserInAccountWithPartitions(ctx, req.(*BlockUserInAccountWithPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExtService_UnblockUserInAccountWithPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserInAccountWithPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServiceServer).UnblockUserInAccountWithPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExtService_UnblockUserInAccountWithPartitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServiceServer).UnblockUserInAccountWithPartitions(ctx, req.(*UnblockUserInAccountWithPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExtService_QueryUserInAccountBlockStatusWithPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserInAccountBlockStatusWithPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServiceServer).QueryUserInAccountBlockStatusWithPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExtService_QueryUserInAccountBlockStatusWithPartitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServiceServer).QueryUserInAccountBlockStatusWithPartitions(ctx, req.(*QueryUserInAccountBlockStatusWithPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExtService_ListUserBlockedDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserBlockedDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServiceServer).ListUserBlockedDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExtService_ListUserBlockedDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServiceServer).ListUserBlockedDetails(ctx, req.(*ListUserBlockedDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExtService_DeleteUserWithOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserWithOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExtServiceServer).DeleteUserWithOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserExtService_DeleteUserWithOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExtServiceServer).DeleteUserWithOptions(ctx, req.(*DeleteUserWithOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserExtService_ServiceDesc is the grpc.ServiceDesc for UserExtService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserExtService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scow.crane_adapter.UserExtService",
+	HandlerType: (*UserExtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetUserAdminLevel",
+			Handler:    _UserExtService_SetUserAdminLevel_Handler,
+		},
+		{
+			MethodName: "AddUserToAccountAsCoordinator",
+			Handler:    _UserExtService_AddUserToAccountAsCoordinator_Handler,
+		},
+		{
+			MethodName: "ListAccountCoordinators",
+			Handler:    _UserExtService_ListAccountCoordinators_Handler,
+		},
+		{
+			MethodName: "ListAccountUserRoles",
+			Handler:    _UserExtService_ListAccountUserRoles_Handler,
+		},
+		{
+			MethodName: "SetUserPartitionQos",
+			Handler:    _UserExtService_SetUserPartitionQos_Handler,
+		},
+		{
+			MethodName: "ClearUserPartitionQos",
+			Handler:    _UserExtService_ClearUserPartitionQos_Handler,
+		},
+		{
+			MethodName: "GetUserPartitionQos",
+			Handler:    _UserExtService_GetUserPartitionQos_Handler,
+		},
+		{
+			MethodName: "BlockUserInAccountWithPartitions",
+			Handler:    _UserExtService_BlockUserInAccountWithPartitions_Handler,
+		},
+		{
+			MethodName: "UnblockUserInAccountWithPartitions",
+			Handler:    _UserExtService_UnblockUserInAccountWithPartitions_Handler,
+		},
+		{
+			MethodName: "QueryUserInAccountBlockStatusWithPartitions",
+			Handler:    _UserExtService_QueryUserInAccountBlockStatusWithPartitions_Handler,
+		},
+		{
+			MethodName: "ListUserBlockedDetails",
+			Handler:    _UserExtService_ListUserBlockedDetails_Handler,
+		},
+		{
+			MethodName: "DeleteUserWithOptions",
+			Handler:    _UserExtService_DeleteUserWithOptions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "adapter/user.proto",
+}
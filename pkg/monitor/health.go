@@ -0,0 +1,163 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+	"scow-crane-adapter/pkg/utils"
+)
+
+const (
+	// CraneCtldHealthService、MongoDBHealthService 依赖组件在健康检查中的服务名，未启用MongoDB时查询mongodb返回NOT_FOUND
+	CraneCtldHealthService = "cranectld"
+	MongoDBHealthService   = "mongodb"
+
+	defaultHealthCheckInterval = 10 * time.Second
+	craneCtldProbeTimeout      = 5 * time.Second
+)
+
+// HealthServer gRPC健康检查服务，服务名为空时为适配器整体的就绪状态
+var HealthServer = health.NewServer()
+
+// readiness 各依赖组件的状态，适配器在CraneCtld可用且(启用检查时)MongoDB可用时就绪
+type readiness struct {
+	mu           sync.Mutex
+	components   map[string]bool
+	services     []string
+	checkMongoDB bool
+	shutdown     bool
+}
+
+var adapterReadiness = &readiness{components: make(map[string]bool)}
+
+// InitHealth 设置跟随就绪状态的服务，第一次探测CraneCtld之前所有服务均为NOT_SERVING
+func InitHealth(services []string, config utils.HealthConfig) {
+	adapterReadiness.mu.Lock()
+	defer adapterReadiness.mu.Unlock()
+	adapterReadiness.services = services
+	adapterReadiness.checkMongoDB = config.CheckMongoDB
+	adapterReadiness.update()
+}
+
+// StartHealthProbe 定期探测CraneCtld，更新就绪状态，ctx结束时停止
+func StartHealthProbe(ctx context.Context, config utils.HealthConfig) {
+	interval := defaultHealthCheckInterval
+	if config.CheckInterval > 0 {
+		interval = time.Duration(config.CheckInterval) * time.Second
+	}
+	go func() {
+		probeCraneCtld()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				probeCraneCtld()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func probeCraneCtld() {
+	ctx, cancel := context.WithTimeout(context.Background(), craneCtldProbeTimeout)
+	defer cancel()
+	_, err := utils.CraneCtld.QueryClusterInfo(ctx, &craneProtos.QueryClusterInfoRequest{}, utils.WithoutRetry())
+	setComponentHealth(CraneCtldHealthService, err)
+}
+
+// setComponentHealth 更新依赖组件的状态，状态变化时输出日志
+func setComponentHealth(component string, err error) {
+	adapterReadiness.mu.Lock()
+	defer adapterReadiness.mu.Unlock()
+
+	up := err == nil
+	if previous, ok := adapterReadiness.components[component]; !ok || previous != up {
+		if up {
+			logrus.Infof("health check: %v is available", component)
+		} else {
+			logrus.Warnf("health check: %v is unavailable: %v", component, err)
+		}
+	}
+	adapterReadiness.components[component] = up
+	HealthServer.SetServingStatus(component, servingStatus(up))
+	adapterReadiness.update()
+}
+
+// ShutdownHealth 退出时将所有服务标记为NOT_SERVING，使负载均衡不再转发新的请求
+func ShutdownHealth() {
+	adapterReadiness.mu.Lock()
+	adapterReadiness.shutdown = true
+	adapterReadiness.mu.Unlock()
+	HealthServer.Shutdown()
+}
+
+// ready 调用方需持有锁
+func (r *readiness) ready() bool {
+	if r.shutdown || !r.components[CraneCtldHealthService] {
+		return false
+	}
+	if mongoDBUp, checked := r.components[MongoDBHealthService]; r.checkMongoDB && checked && !mongoDBUp {
+		return false
+	}
+	return true
+}
+
+// update 根据依赖组件的状态更新整体及各服务的状态，调用方需持有锁
+func (r *readiness) update() {
+	status := servingStatus(r.ready())
+	HealthServer.SetServingStatus("", status)
+	for _, service := range r.services {
+		HealthServer.SetServingStatus(service, status)
+	}
+}
+
+func servingStatus(up bool) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if up {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
+
+// HealthzHandler 存活检查，进程能处理HTTP请求即返回200
+func HealthzHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "ok")
+	})
+}
+
+// ReadyzHandler 就绪检查，未就绪时返回503，响应中列出各依赖组件的状态
+func ReadyzHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		adapterReadiness.mu.Lock()
+		ready := adapterReadiness.ready()
+		var components []string
+		for component := range adapterReadiness.components {
+			components = append(components, component)
+		}
+		sort.Strings(components)
+		var lines []string
+		for _, component := range components {
+			lines = append(lines, fmt.Sprintf("%v: %v", component, servingStatus(adapterReadiness.components[component])))
+		}
+		adapterReadiness.mu.Unlock()
+
+		if !ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		for _, line := range lines {
+			fmt.Fprintln(w, line)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"scow-crane-adapter/pkg/utils"
+)
+
+func readyzStatus() (int, string) {
+	recorder := httptest.NewRecorder()
+	ReadyzHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	return recorder.Code, recorder.Body.String()
+}
+
+func TestReadiness(t *testing.T) {
+	adapterReadiness = &readiness{components: make(map[string]bool)}
+	InitHealth([]string{"scow.scheduler_adapter.JobService"}, utils.HealthConfig{CheckMongoDB: true})
+
+	// 探测CraneCtld之前未就绪
+	code, _ := readyzStatus()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	setComponentHealth(CraneCtldHealthService, nil)
+	code, body := readyzStatus()
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "cranectld: SERVING\n", body)
+	res, err := HealthServer.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "scow.scheduler_adapter.JobService"})
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.Status)
+
+	setComponentHealth(MongoDBHealthService, errors.New("connection refused"))
+	code, body = readyzStatus()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "cranectld: SERVING\nmongodb: NOT_SERVING\n", body)
+
+	// 未启用MongoDB检查时只看CraneCtld
+	InitHealth([]string{"scow.scheduler_adapter.JobService"}, utils.HealthConfig{})
+	code, _ = readyzStatus()
+	assert.Equal(t, http.StatusOK, code)
+
+	setComponentHealth(CraneCtldHealthService, errors.New("unavailable"))
+	code, _ = readyzStatus()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
@@ -46,6 +46,22 @@ var (
 		Help: "Size of database in bytes",
 	}, []string{"database"})
 
+	DatabaseUp = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "database_up",
+		Help: "Whether the database is reachable, absent when the database integration is disabled",
+	}, []string{"database"})
+
+	// CraneCtldEndpointHealthy CraneCtld地址指标，active为1的地址为当前调用发往的地址
+	CraneCtldEndpointHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "crane_ctld_endpoint_healthy",
+		Help: "Whether the CraneCtld endpoint passed the last health check",
+	}, []string{"endpoint"})
+
+	CraneCtldEndpointActive = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "crane_ctld_endpoint_active",
+		Help: "Whether the CraneCtld endpoint is serving the adapter's calls",
+	}, []string{"endpoint"})
+
 	// MetricsRequestDuration Metrics接口性能指标
 	MetricsRequestDuration = promauto.NewHistogram(prometheus.HistogramOpts{
 		Name:    "metrics_endpoint_duration_seconds",
@@ -2,6 +2,7 @@ package monitor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"runtime"
@@ -15,7 +16,8 @@ import (
 	"scow-crane-adapter/pkg/utils"
 )
 
-func StartSystemMetricsCollector() {
+// StartSystemMetricsCollector 定期采集指标，ctx结束时停止
+func StartSystemMetricsCollector(ctx context.Context) {
 	go func() {
 		proc, err := process.NewProcess(int32(os.Getpid()))
 		if err != nil {
@@ -25,12 +27,21 @@ func StartSystemMetricsCollector() {
 		ticker := time.NewTicker(5 * time.Second)
 		defer ticker.Stop()
 
-		for range ticker.C {
+		for {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+
 			// 采集进程级别指标
 			collectProcessMetrics(proc)
 
 			// 采集数据库指标
 			collectDatabaseMetrics()
+
+			// 采集CraneCtld地址指标
+			collectCraneEndpointMetrics()
 		}
 	}()
 }
@@ -52,31 +63,61 @@ func collectProcessMetrics(proc *process.Process) {
 	ProcessGoroutines.Set(float64(runtime.NumGoroutine()))
 }
 
+func collectCraneEndpointMetrics() {
+	for _, state := range utils.GetCraneEndpointStates() {
+		CraneCtldEndpointHealthy.WithLabelValues(state.Addr).Set(boolToFloat(state.Healthy))
+		CraneCtldEndpointActive.WithLabelValues(state.Addr).Set(boolToFloat(state.Active))
+	}
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 func collectDatabaseMetrics() {
 	// MongoDB数据库监控
 	collectMongoDBMetrics()
 }
 
 func collectMongoDBMetrics() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	client, config, err := utils.GetMongoDBClient(ctx)
+	if errors.Is(err, utils.ErrDatabaseDisabled) {
+		return
+	}
+	if err == nil {
+		err = client.Ping(ctx, nil)
+	}
+	DatabaseUp.WithLabelValues("mongodb").Set(boolToFloat(err == nil))
+	setComponentHealth(MongoDBHealthService, err)
+	if err != nil {
+		return
+	}
+
 	// 获取连接数
-	connCount, err := getConnectionCount(utils.MongoDBClient)
+	connCount, err := getConnectionCount(ctx, client)
 	if err != nil {
-		logrus.Errorf("Failed to get active connections: %v", err)
+		logrus.Debugf("Failed to get active connections: %v", err)
 	} else {
 		DatabaseConnections.WithLabelValues("database", "active").Set(float64(connCount))
 	}
 
 	// 获取数据库大小
-	sizes, err := getDatabaseSize(utils.MongoDBClient, utils.MongoDBConfig.DbName)
+	sizes, err := getDatabaseSize(ctx, client, config.DbName)
 	if err != nil {
-		logrus.Errorf("Failed to get database sizes: %v", err)
+		logrus.Debugf("Failed to get database sizes: %v", err)
 	} else {
-		DatabaseSize.WithLabelValues(utils.MongoDBConfig.DbName).Set(float64(sizes))
+		DatabaseSize.WithLabelValues(config.DbName).Set(float64(sizes))
 	}
 }
 
 // 获取数据库连接数
-func getConnectionCount(client *mongo.Client) (int, error) {
+func getConnectionCount(ctx context.Context, client *mongo.Client) (int, error) {
 	// 在 admin 数据库上执行 serverStatus 命令
 	var result struct {
 		Connections struct {
@@ -85,7 +126,7 @@ func getConnectionCount(client *mongo.Client) (int, error) {
 	}
 
 	cmd := bson.D{{Key: "serverStatus", Value: 1}}
-	err := client.Database("admin").RunCommand(context.TODO(), cmd).Decode(&result)
+	err := client.Database("admin").RunCommand(ctx, cmd).Decode(&result)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get the number of connections: %v", err)
 	}
@@ -94,14 +135,14 @@ func getConnectionCount(client *mongo.Client) (int, error) {
 }
 
 // 获取数据库大小
-func getDatabaseSize(client *mongo.Client, dbName string) (int64, error) {
+func getDatabaseSize(ctx context.Context, client *mongo.Client, dbName string) (int64, error) {
 	// 获取数据库状态
 	var result struct {
 		TotalSize int64 `bson:"totalSize"`
 	}
 
 	cmd := bson.D{{Key: "dbStats", Value: 1}}
-	err := client.Database(dbName).RunCommand(context.TODO(), cmd).Decode(&result)
+	err := client.Database(dbName).RunCommand(ctx, cmd).Decode(&result)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get database size: %w", err)
 	}
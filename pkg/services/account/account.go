@@ -3,13 +3,13 @@ package account
 import (
 	"context"
 	"fmt"
-	"os"
 	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 
+	adapterProtos "scow-crane-adapter/gen/adapter"
 	craneProtos "scow-crane-adapter/gen/crane"
 	protos "scow-crane-adapter/gen/go"
 	sau "scow-crane-adapter/pkg/services/account/sync_account_user"
@@ -22,12 +22,19 @@ type ServerAccount struct {
 	muUnBlock sync.Mutex
 }
 
+// ServerAccountExt 提供AccountExtService，CreateAccount与scow的接口同名，其余方法沿用ServerAccount
+type ServerAccountExt struct {
+	*ServerAccount
+}
+
 func (s *ServerAccount) ListAccounts(ctx context.Context, in *protos.ListAccountsRequest) (*protos.ListAccountsResponse, error) {
-	accountList, err := utils.GetAccountByUser(in.UserId)
+	accountList, err := utils.GetAccountByUser(ctx, in.UserId)
 	if err != nil {
 		logrus.Errorf("ListAccounts failed: %v", err)
 		return nil, utils.RichError(codes.Internal, "ListAccounts failed", err.Error())
 	}
+	// 已归档的账户不再列出
+	accountList = utils.FilterArchivedAccountNames(accountList)
 
 	logrus.Tracef("ListAccounts accounts: %v", accountList)
 	return &protos.ListAccountsResponse{Accounts: accountList}, nil
@@ -36,26 +43,48 @@ func (s *ServerAccount) ListAccounts(ctx context.Context, in *protos.ListAccount
 func (s *ServerAccount) CreateAccount(ctx context.Context, in *protos.CreateAccountRequest) (*protos.CreateAccountResponse, error) {
 	logrus.Infof("Received request CreateAccount: %v", in)
 
+	if err := createAccount(ctx, in.AccountName, in.OwnerUserId, "", ""); err != nil {
+		return nil, err
+	}
+	return &protos.CreateAccountResponse{}, nil
+}
+
+// CreateAccount 创建账户，可以指定父账户和描述
+func (s *ServerAccountExt) CreateAccount(ctx context.Context, in *adapterProtos.CreateAccountRequest) (*adapterProtos.CreateAccountResponse, error) {
+	logrus.Infof("Received request AccountExt CreateAccount: %v", in)
+
+	if err := createAccount(ctx, in.GetAccountName(), in.GetOwnerUserId(), in.GetParentAccount(), in.GetDescription()); err != nil {
+		return nil, err
+	}
+	return &adapterProtos.CreateAccountResponse{}, nil
+}
+
+// createAccount 创建账户后将用户添加至账户中，返回的错误可以直接返回给客户端
+func createAccount(ctx context.Context, accountName, ownerUserId, parentAccount, description string) error {
 	// 检查账户名
-	if err := utils.CheckAccount(in.AccountName); err != nil {
+	if err := utils.CheckAccount(accountName); err != nil {
 		logrus.Errorf("CreateAccount failed: %v", err)
-		return nil, utils.RichError(codes.Internal, "ACCOUNT_ILLEGAL", err.Error())
+		return utils.RichError(codes.Internal, "ACCOUNT_ILLEGAL", err.Error())
+	}
+	if err := utils.CheckAccount(parentAccount); err != nil {
+		logrus.Errorf("CreateAccount failed: %v", err)
+		return utils.RichError(codes.Internal, "ACCOUNT_ILLEGAL", err.Error())
 	}
 
-	if err := utils.CreateAccount(in.AccountName); err != nil {
-		logrus.Errorf("create account %v failed: %v", in.AccountName, err)
-		return nil, utils.RichError(codes.Internal, "CRANE_INTERNAL_ERROR", err.Error())
+	if err := utils.CreateAccount(ctx, accountName, parentAccount, description); err != nil {
+		logrus.Errorf("create account %v failed: %v", accountName, err)
+		return utils.RichError(codes.Internal, "CRANE_INTERNAL_ERROR", err.Error())
 	}
-	logrus.Tracef("create account: %v success", in.AccountName)
+	logrus.Tracef("create account: %v success", accountName)
 
 	// 账户创建成功后，将用户添加至账户中
-	if err := utils.AddUserToAccount(in.AccountName, in.OwnerUserId); err != nil {
+	if err := utils.AddUserToAccount(ctx, accountName, ownerUserId); err != nil {
 		logrus.Errorf("CreateAccount err: %v", err)
-		return nil, utils.RichError(codes.Internal, "CRANE_CALL_FAILED", err.Error())
+		return utils.CraneCallError(err)
 	}
 
-	logrus.Tracef("add user : %v to account: %v success", in.OwnerUserId, in.AccountName)
-	return &protos.CreateAccountResponse{}, nil
+	logrus.Tracef("add user : %v to account: %v success", ownerUserId, accountName)
+	return nil
 }
 
 func (s *ServerAccount) BlockAccount(ctx context.Context, in *protos.BlockAccountRequest) (*protos.BlockAccountResponse, error) {
@@ -70,10 +99,10 @@ func (s *ServerAccount) BlockAccount(ctx context.Context, in *protos.BlockAccoun
 	}
 
 	// 先查询账户
-	account, err := utils.GetAccountByName(in.AccountName)
+	account, err := utils.GetAccountByName(ctx, in.AccountName)
 	if err != nil {
 		logrus.Errorf("BlockAccount get account failed: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 
 	if account.Blocked {
@@ -82,9 +111,9 @@ func (s *ServerAccount) BlockAccount(ctx context.Context, in *protos.BlockAccoun
 	}
 
 	// 封锁账户时将账户的Blocked字段置为true
-	if err := utils.BlockAccount(in.AccountName); err != nil {
+	if err := utils.BlockAccount(ctx, in.AccountName); err != nil {
 		logrus.Errorf("BlockAccount err: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 
 	logrus.Infof("BlockAccount account: %v success", in.AccountName)
@@ -103,9 +132,9 @@ func (s *ServerAccount) UnblockAccount(ctx context.Context, in *protos.UnblockAc
 	}
 
 	// 解封账户时将账户的Blocked字段置为false
-	if err := utils.UnblockAccount(in.AccountName); err != nil {
+	if err := utils.UnblockAccount(ctx, in.AccountName); err != nil {
 		logrus.Errorf("BlockAccount err: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, unblockAccountError(err)
 	}
 
 	logrus.Infof("UnblockAccount account: %v success", in.AccountName)
@@ -116,11 +145,12 @@ func (s *ServerAccount) GetAllAccountsWithUsers(ctx context.Context, in *protos.
 	var accounts []*protos.ClusterAccountInfo
 
 	logrus.Infof("Received request GetAllAccountsWithUsers: %v", in)
-	allAccount, err := utils.GetAllAccount()
+	allAccount, err := utils.GetAllAccount(ctx)
 	if err != nil {
 		logrus.Errorf("GetAllAccountsWithUsers err: %v", err)
 		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
 	}
+	allAccount = utils.FilterArchivedAccounts(allAccount)
 	// 获取所有账户信息
 	for _, account := range allAccount {
 		var userInfo []*protos.ClusterAccountInfo_UserInAccount
@@ -129,7 +159,7 @@ func (s *ServerAccount) GetAllAccountsWithUsers(ctx context.Context, in *protos.
 			Account: account.GetName(),
 		}
 		// 获取单个账户下用户信息
-		responseUser, _ := utils.CraneCtld.QueryUserInfo(context.Background(), requestUser)
+		responseUser, _ := utils.CraneCtld.QueryUserInfo(ctx, requestUser)
 		for _, user := range responseUser.GetUserList() {
 			userInfo = append(userInfo, &protos.ClusterAccountInfo_UserInAccount{
 				UserId:   user.GetName(),
@@ -143,6 +173,7 @@ func (s *ServerAccount) GetAllAccountsWithUsers(ctx context.Context, in *protos.
 			Users:       userInfo,
 		})
 	}
+
 	logrus.Tracef("GetAllAccountsWithUsers Accounts: %v", accounts)
 	return &protos.GetAllAccountsWithUsersResponse{Accounts: accounts}, nil
 }
@@ -158,14 +189,14 @@ func (s *ServerAccount) QueryAccountBlockStatus(ctx context.Context, in *protos.
 	}
 
 	// 查询账户
-	account, err := utils.GetAccountByName(in.AccountName)
+	account, err := utils.GetAccountByName(ctx, in.AccountName)
 	if err != nil {
 		logrus.Errorf("QueryAccountBlockStatus err: %v", err)
 		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
 	}
 
 	// 获取所有分区
-	partitions := utils.GetAllPartitions()
+	partitions := utils.GetAllPartitions(ctx)
 
 	// 获取账户的blocked
 	accountBlocked := account.GetBlocked()
@@ -204,39 +235,10 @@ func (s *ServerAccount) QueryAccountBlockStatus(ctx context.Context, in *protos.
 func (s *ServerAccount) DeleteAccount(ctx context.Context, in *protos.DeleteAccountRequest) (*protos.DeleteAccountResponse, error) {
 	logrus.Infof("Received request DeleteAccount: %v", in)
 
-	// 检查账户名
-	if err := utils.CheckAccount(in.AccountName); err != nil {
-		logrus.Errorf("DeleteAccount failed: %v", err)
-		return nil, utils.RichError(codes.Internal, "ACCOUNT_ILLEGAL", err.Error())
-	}
-
-	jobInfo, err := utils.GetTaskByAccountName([]string{in.AccountName})
-	if err != nil {
-		logrus.Errorf("DeleteAccount err: %v", err)
-		return nil, utils.RichError(codes.Internal, "CRANE_RUNCOMMAND_ERROR", err.Error())
-	}
-	logrus.Tracef("DeleteAccount job info: %v", jobInfo)
-
-	runningJobs := len(jobInfo)
-	if runningJobs != 0 {
-		logrus.Errorf("DeleteAccount failed: %v", fmt.Errorf("exist running jobs"))
+	if _, err := deleteAccount(ctx, in.AccountName, false, false, 0); err != nil {
 		return nil, err
 	}
 
-	// 创建删除账户请求体
-	deleteAccountRequest := &craneProtos.DeleteAccountRequest{
-		Uid:         uint32(os.Getuid()),
-		AccountList: []string{in.AccountName},
-	}
-	response, err := utils.CraneCtld.DeleteAccount(context.Background(), deleteAccountRequest)
-	if err != nil {
-		logrus.Errorf("DeleteAccount err: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
-	}
-	if !response.GetOk() {
-		logrus.Errorf("DeleteAccount failed: %v", fmt.Errorf("ASSOCIATION_NOT_EXISTS"))
-		return nil, utils.RichError(codes.NotFound, "ASSOCIATION_NOT_EXISTS", response.RichErrorList[0].GetDescription())
-	}
 	logrus.Infof("DeleteAccount: %v success", in.AccountName)
 	return &protos.DeleteAccountResponse{}, nil
 }
@@ -259,7 +261,7 @@ func (s *ServerAccount) BlockAccountWithPartitions(ctx context.Context, in *prot
 	}
 
 	// 查询账户
-	account, err := utils.GetAccountByName(in.AccountName)
+	account, err := utils.GetAccountByName(ctx, in.AccountName)
 	if err != nil {
 		logrus.Errorf("BlockAccountWithPartitions err: %v", err)
 		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
@@ -281,9 +283,9 @@ func (s *ServerAccount) BlockAccountWithPartitions(ctx context.Context, in *prot
 		return &protos.BlockAccountWithPartitionsResponse{}, nil
 	}
 
-	if err = utils.BlockAccountWithPartition(in.AccountName, needBlockPartitions); err != nil {
+	if err = utils.BlockAccountWithPartition(ctx, in.AccountName, needBlockPartitions); err != nil {
 		logrus.Errorf("BlockAccount err: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 
 	logrus.Infof("BlockAccountWithPartitions account: %v success", in.AccountName)
@@ -307,7 +309,7 @@ func (s *ServerAccount) UnblockAccountWithPartitions(ctx context.Context, in *pr
 	}
 
 	// 查询账户
-	account, err := utils.GetAccountByName(in.AccountName)
+	account, err := utils.GetAccountByName(ctx, in.AccountName)
 	if err != nil {
 		logrus.Errorf("UnblockAccountWithPartitions err: %v", err)
 		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
@@ -315,9 +317,9 @@ func (s *ServerAccount) UnblockAccountWithPartitions(ctx context.Context, in *pr
 
 	if account.Blocked {
 		// 先将账户的Blocked字段置为false
-		if err = utils.UnblockAccount(in.AccountName); err != nil {
+		if err = utils.UnblockAccount(ctx, in.AccountName); err != nil {
 			logrus.Errorf("BlockAccount err: %v", err)
-			return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+			return nil, unblockAccountError(err)
 		}
 	}
 
@@ -338,9 +340,9 @@ func (s *ServerAccount) UnblockAccountWithPartitions(ctx context.Context, in *pr
 	}
 
 	// 还需添加账户的allowPartitions
-	if err := utils.UnblockAccountWithPartition(in.AccountName, needUnblockPartitions); err != nil {
+	if err := utils.UnblockAccountWithPartition(ctx, in.AccountName, needUnblockPartitions); err != nil {
 		logrus.Errorf("BlockAccount err: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 
 	logrus.Infof("UnblockAccountWithPartitions account: %v success", in.AccountName)
@@ -361,7 +363,7 @@ func (s *ServerAccount) QueryAccountBlockStatusWithPartitions(ctx context.Contex
 	}
 
 	// 查询账户
-	account, err := utils.GetAccountByName(in.AccountName)
+	account, err := utils.GetAccountByName(ctx, in.AccountName)
 	if err != nil {
 		logrus.Errorf("QueryAccountBlockStatusWithPartitions err: %v", err)
 		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
@@ -369,7 +371,7 @@ func (s *ServerAccount) QueryAccountBlockStatusWithPartitions(ctx context.Contex
 
 	// 获取计算分区信息
 	if len(in.QueriedPartitions) == 0 {
-		queriedPartitions = utils.GetAllPartitions()
+		queriedPartitions = utils.GetAllPartitions(ctx)
 	} else {
 		queriedPartitions = in.QueriedPartitions
 	}
@@ -411,20 +413,21 @@ func (s *ServerAccount) GetAllAccountsWithUsersAndBlockedDetails(ctx context.Con
 	logrus.Infof("Received request GetAllAccountsWithUsersAndBlockedDetails: %v", in)
 
 	var acctInfo []*protos.ClusterAccountInfoWithBlockedDetails
-	// 1. 获取所有账户
-	allAccount, err := utils.GetAllAccount()
+	// 1. 获取所有账户，已归档的账户不再列出
+	allAccount, err := utils.GetAllAccount(ctx)
 	if err != nil {
 		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
 	}
+	allAccount = utils.FilterArchivedAccounts(allAccount)
 	// 2. 获取所有账户的用户信息
-	accountUserInfoMap, err := utils.GetAllAccountUserInfoConcurrently(allAccount)
+	accountUserInfoMap, err := utils.GetAllAccountUserInfoConcurrently(ctx, allAccount)
 	if err != nil {
 		logrus.Errorf("GetAllAccountsWithUsersAndBlockedDetails err: %v", err)
 		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
 	}
 
 	// 3. 获取所有分区
-	partitions := utils.GetAllPartitions()
+	partitions := utils.GetAllPartitions(ctx)
 
 	// 4. 获取和每个账户关联的用户的信息以及用户的block状态
 	for account, users := range accountUserInfoMap {
@@ -525,7 +528,7 @@ outerLoop:
 			continue
 		}
 
-		results := sau.SyncAccountUser(syncAccount)
+		results := sau.SyncAccountUser(ctx, syncAccount)
 		for _, result := range results {
 			if result == nil {
 				continue
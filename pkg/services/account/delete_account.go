@@ -0,0 +1,145 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	"scow-crane-adapter/pkg/utils"
+)
+
+// 删除失败后解封账户的超时，不受请求取消的影响
+const unblockAfterFailedDeleteTimeout = 30 * time.Second
+
+func (s *ServerAccount) DeleteAccountWithOptions(ctx context.Context, in *adapterProtos.DeleteAccountWithOptionsRequest) (*adapterProtos.DeleteAccountWithOptionsResponse, error) {
+	logrus.Infof("Received request DeleteAccountWithOptions: %v", in)
+
+	cancelledJobIds, err := deleteAccount(ctx, in.AccountName, in.CancelJobs, in.SoftDelete, in.CancelJobsWaitTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("DeleteAccountWithOptions: %v success, soft delete: %v", in.AccountName, in.SoftDelete)
+	return &adapterProtos.DeleteAccountWithOptionsResponse{
+		CancelledJobIds: cancelledJobIds,
+		SoftDeleted:     in.SoftDelete,
+	}, nil
+}
+
+// deleteAccount 删除账户，只有排队和运行中的作业会阻止删除，
+// cancelJobs为true时先取消这些作业并最多等待waitTimeout秒，softDelete为true时只封锁并归档账户，
+// 已归档的账户在softDelete为false时彻底删除
+func deleteAccount(ctx context.Context, accountName string, cancelJobs, softDelete bool, waitTimeout uint32) (_ []uint32, err error) {
+	// 检查账户名
+	if err := utils.CheckAccount(accountName); err != nil {
+		logrus.Errorf("DeleteAccount failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	account, err := utils.GetAccountByName(ctx, accountName)
+	if err != nil {
+		logrus.Errorf("DeleteAccount err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+	// 子账户不随父账户删除或归档
+	if len(account.GetChildAccounts()) != 0 {
+		message := fmt.Sprintf("account %v has child accounts %v", accountName, account.GetChildAccounts())
+		logrus.Errorf("DeleteAccount failed: %v", message)
+		return nil, utils.RichError(codes.FailedPrecondition, "ACCOUNT_HAS_CHILDREN", message)
+	}
+	archived, err := utils.IsAccountArchived(accountName)
+	if err != nil {
+		logrus.Errorf("DeleteAccount err: %v", err)
+		return nil, utils.RichError(codes.Internal, "ACCOUNT_ARCHIVE_FAILED", err.Error())
+	}
+
+	taskIds, err := utils.GetUnfinishedTaskIdsByAccountName(ctx, accountName)
+	if err != nil {
+		logrus.Errorf("DeleteAccount err: %v", err)
+		return nil, utils.CraneCallError(err)
+	}
+	logrus.Tracef("DeleteAccount unfinished jobs of %v: %v", accountName, taskIds)
+
+	// 先封锁账户，避免取消作业期间又有新的作业提交
+	if softDelete || (cancelJobs && len(taskIds) != 0) {
+		if !account.GetBlocked() {
+			if err = utils.BlockAccount(ctx, accountName); err != nil {
+				logrus.Errorf("DeleteAccount block account %v err: %v", accountName, err)
+				return nil, utils.CraneCallError(err)
+			}
+			// 彻底删除失败时解封本次封锁的账户，软删除的账户保持封锁
+			if !softDelete {
+				defer func() {
+					if err != nil {
+						unblockAccountAfterFailedDelete(ctx, accountName)
+					}
+				}()
+			}
+		}
+		// 重复软删除时保留第一次归档的信息
+		if softDelete && !archived {
+			if err = utils.ArchiveAccount(ctx, account); err != nil {
+				logrus.Errorf("DeleteAccount archive account %v err: %v", accountName, err)
+				return nil, utils.RichError(codes.Internal, "ACCOUNT_ARCHIVE_FAILED", err.Error())
+			}
+		}
+	}
+
+	remaining := taskIds
+	if cancelJobs && len(taskIds) != 0 {
+		remaining, err = utils.CancelAccountTasks(ctx, accountName, taskIds, utils.CancelJobsWaitTimeout(waitTimeout))
+		if err != nil {
+			logrus.Errorf("DeleteAccount cancel jobs of %v err: %v", accountName, err)
+			return nil, utils.CraneCallError(err)
+		}
+	}
+	cancelledJobIds := utils.SliceSubtract(taskIds, remaining)
+
+	if softDelete {
+		logrus.Infof("DeleteAccount: %v blocked and archived", accountName)
+		return cancelledJobIds, nil
+	}
+
+	if len(remaining) != 0 {
+		message := fmt.Sprintf("account %v has unfinished jobs %v", accountName, remaining)
+		logrus.Errorf("DeleteAccount failed: %v", message)
+		return nil, utils.RichErrorWithMetadata(codes.FailedPrecondition, "EXIST_UNFINISHED_JOBS", message, map[string]string{
+			"job_ids": utils.JoinTaskIds(remaining),
+		})
+	}
+
+	if err = utils.DeleteAccount(ctx, accountName); err != nil {
+		logrus.Errorf("DeleteAccount err: %v", err)
+		return nil, deleteAccountError(err)
+	}
+	return cancelledJobIds, nil
+}
+
+// unblockAccountAfterFailedDelete 解封删除失败的账户，请求被取消时同样需要解封
+func unblockAccountAfterFailedDelete(ctx context.Context, accountName string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), unblockAfterFailedDeleteTimeout)
+	defer cancel()
+	if err := utils.UnblockAccount(ctx, accountName); err != nil {
+		logrus.Errorf("DeleteAccount unblock account %v after failed delete err: %v", accountName, err)
+		return
+	}
+	logrus.Infof("DeleteAccount account %v is not deleted, unblocked", accountName)
+}
+
+// deleteAccountError 部分用户移除失败时返回已移除和移除失败的用户，其他错误视为调用CraneCtld失败
+func deleteAccountError(err error) error {
+	var usersErr *utils.AccountUsersError
+	if errors.As(err, &usersErr) {
+		return utils.RichErrorWithMetadata(codes.Aborted, "ACCOUNT_USERS_PARTIALLY_REMOVED", usersErr.Error(), map[string]string{
+			"removed_users": strings.Join(usersErr.Removed, ","),
+			"failed_users":  strings.Join(usersErr.Failed, ","),
+		})
+	}
+	return utils.ServiceError(err)
+}
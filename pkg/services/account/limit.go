@@ -0,0 +1,291 @@
+package account
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	craneProtos "scow-crane-adapter/gen/crane"
+	"scow-crane-adapter/pkg/utils"
+)
+
+var limitFieldMap = map[adapterProtos.LimitField]craneProtos.ModifyField{
+	adapterProtos.LimitField_MAX_JOBS:               craneProtos.ModifyField_MaxJobsPerUser,
+	adapterProtos.LimitField_MAX_CPUS:               craneProtos.ModifyField_MaxCpusPerUser,
+	adapterProtos.LimitField_MAX_TIME_LIMIT_SECONDS: craneProtos.ModifyField_MaxTimeLimitPerTask,
+}
+
+func toResourceLimit(limits *adapterProtos.ResourceLimits) *utils.ResourceLimit {
+	limit := &utils.ResourceLimit{
+		MaxTimeLimit: limits.MaxTimeLimitSeconds,
+	}
+	if limits.MaxJobs != nil {
+		value := uint64(limits.GetMaxJobs())
+		limit.MaxJobs = &value
+	}
+	if limits.MaxCpus != nil {
+		value := uint64(limits.GetMaxCpus())
+		limit.MaxCpus = &value
+	}
+	return limit
+}
+
+func fromResourceLimit(limit *utils.ResourceLimit) *adapterProtos.ResourceLimits {
+	limits := &adapterProtos.ResourceLimits{
+		MaxTimeLimitSeconds: limit.MaxTimeLimit,
+	}
+	if limit.MaxJobs != nil {
+		limits.MaxJobs = proto.Uint32(uint32(*limit.MaxJobs))
+	}
+	if limit.MaxCpus != nil {
+		limits.MaxCpus = proto.Uint32(uint32(*limit.MaxCpus))
+	}
+	return limits
+}
+
+func toLimitFields(fields []adapterProtos.LimitField) ([]craneProtos.ModifyField, error) {
+	var modifyFields []craneProtos.ModifyField
+	for _, field := range fields {
+		if field == adapterProtos.LimitField_MAX_SUBMIT_JOBS {
+			return nil, utils.RichError(codes.Unimplemented, "LIMIT_FIELD_UNSUPPORTED", "max_submit_jobs is not supported by crane")
+		}
+		modifyField, ok := limitFieldMap[field]
+		if !ok {
+			return nil, utils.RichError(codes.InvalidArgument, "LIMIT_FIELD_ILLEGAL", "unknown limit field "+field.String())
+		}
+		modifyFields = append(modifyFields, modifyField)
+	}
+	return modifyFields, nil
+}
+
+// checkLimitsSupported 鹤思没有限制提交作业数的字段，设置时返回UNIMPLEMENTED而不是忽略
+func checkLimitsSupported(limits *adapterProtos.ResourceLimits) error {
+	if limits.MaxSubmitJobs != nil {
+		return utils.RichError(codes.Unimplemented, "LIMIT_FIELD_UNSUPPORTED", "max_submit_jobs is not supported by crane")
+	}
+	return nil
+}
+
+func (s *ServerAccount) SetAccountLimits(ctx context.Context, in *adapterProtos.SetAccountLimitsRequest) (*adapterProtos.SetAccountLimitsResponse, error) {
+	logrus.Infof("Received request SetAccountLimits: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("SetAccountLimits failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+	if in.Limits == nil {
+		logrus.Infof("SetAccountLimits: %v no limit need set", in.AccountName)
+		return &adapterProtos.SetAccountLimitsResponse{}, nil
+	}
+	if err := checkLimitsSupported(in.Limits); err != nil {
+		logrus.Errorf("SetAccountLimits failed: %v", err)
+		return nil, err
+	}
+
+	if err := utils.SetAccountLimit(ctx, in.AccountName, toResourceLimit(in.Limits)); err != nil {
+		logrus.Errorf("SetAccountLimits err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+
+	logrus.Infof("SetAccountLimits account: %v success", in.AccountName)
+	return &adapterProtos.SetAccountLimitsResponse{}, nil
+}
+
+func (s *ServerAccount) ClearAccountLimits(ctx context.Context, in *adapterProtos.ClearAccountLimitsRequest) (*adapterProtos.ClearAccountLimitsResponse, error) {
+	logrus.Infof("Received request ClearAccountLimits: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("ClearAccountLimits failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+	fields, err := toLimitFields(in.Fields)
+	if err != nil {
+		logrus.Errorf("ClearAccountLimits failed: %v", err)
+		return nil, err
+	}
+
+	if err = utils.ClearAccountLimit(ctx, in.AccountName, fields); err != nil {
+		logrus.Errorf("ClearAccountLimits err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+
+	logrus.Infof("ClearAccountLimits account: %v success", in.AccountName)
+	return &adapterProtos.ClearAccountLimitsResponse{}, nil
+}
+
+func (s *ServerAccount) GetAccountLimits(ctx context.Context, in *adapterProtos.GetAccountLimitsRequest) (*adapterProtos.GetAccountLimitsResponse, error) {
+	logrus.Infof("Received request GetAccountLimits: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("GetAccountLimits failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	account, err := utils.GetAccountByName(ctx, in.AccountName)
+	if err != nil {
+		logrus.Errorf("GetAccountLimits err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+	qosMap, err := utils.GetQosInfoMap(ctx)
+	if err != nil {
+		logrus.Errorf("GetAccountLimits err: %v", err)
+		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
+	}
+
+	limit, err := utils.GetAccountLimit(in.AccountName)
+	if err != nil {
+		logrus.Errorf("GetAccountLimits err: %v", err)
+		return nil, utils.RichError(codes.Internal, "LIMIT_READ_FAILED", err.Error())
+	}
+	effectiveLimit, err := utils.GetEffectiveAccountLimit(account, qosMap)
+	if err != nil {
+		logrus.Errorf("GetAccountLimits err: %v", err)
+		return nil, utils.RichError(codes.Internal, "LIMIT_READ_FAILED", err.Error())
+	}
+
+	logrus.Tracef("GetAccountLimits account: %v limit: %v effective: %v", in.AccountName, limit, effectiveLimit)
+	return &adapterProtos.GetAccountLimitsResponse{
+		Limits:          fromResourceLimit(limit),
+		EffectiveLimits: fromResourceLimit(effectiveLimit),
+	}, nil
+}
+
+func (s *ServerAccount) SetUserLimits(ctx context.Context, in *adapterProtos.SetUserLimitsRequest) (*adapterProtos.SetUserLimitsResponse, error) {
+	logrus.Infof("Received request SetUserLimits: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("SetUserLimits failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+	if in.Limits == nil {
+		logrus.Infof("SetUserLimits: %v in %v no limit need set", in.UserId, in.AccountName)
+		return &adapterProtos.SetUserLimitsResponse{}, nil
+	}
+	if err := checkLimitsSupported(in.Limits); err != nil {
+		logrus.Errorf("SetUserLimits failed: %v", err)
+		return nil, err
+	}
+
+	if err := utils.SetUserLimit(ctx, in.UserId, in.AccountName, toResourceLimit(in.Limits)); err != nil {
+		logrus.Errorf("SetUserLimits err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+
+	logrus.Infof("SetUserLimits user: %v account: %v success", in.UserId, in.AccountName)
+	return &adapterProtos.SetUserLimitsResponse{}, nil
+}
+
+func (s *ServerAccount) ClearUserLimits(ctx context.Context, in *adapterProtos.ClearUserLimitsRequest) (*adapterProtos.ClearUserLimitsResponse, error) {
+	logrus.Infof("Received request ClearUserLimits: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("ClearUserLimits failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+	fields, err := toLimitFields(in.Fields)
+	if err != nil {
+		logrus.Errorf("ClearUserLimits failed: %v", err)
+		return nil, err
+	}
+
+	if err = utils.ClearUserLimit(ctx, in.UserId, in.AccountName, fields); err != nil {
+		logrus.Errorf("ClearUserLimits err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+
+	logrus.Infof("ClearUserLimits user: %v account: %v success", in.UserId, in.AccountName)
+	return &adapterProtos.ClearUserLimitsResponse{}, nil
+}
+
+func (s *ServerAccount) GetUserLimits(ctx context.Context, in *adapterProtos.GetUserLimitsRequest) (*adapterProtos.GetUserLimitsResponse, error) {
+	logrus.Infof("Received request GetUserLimits: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("GetUserLimits failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	account, err := utils.GetAccountByName(ctx, in.AccountName)
+	if err != nil {
+		logrus.Errorf("GetUserLimits err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+	if !utils.Contains(account.GetUsers(), in.UserId) {
+		logrus.Errorf("GetUserLimits failed: user %v not in account %v", in.UserId, in.AccountName)
+		return nil, utils.RichError(codes.NotFound, "USER_NOT_FOUND", "user not in account")
+	}
+	qosMap, err := utils.GetQosInfoMap(ctx)
+	if err != nil {
+		logrus.Errorf("GetUserLimits err: %v", err)
+		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
+	}
+
+	limit, err := utils.GetUserLimit(in.UserId, in.AccountName)
+	if err != nil {
+		logrus.Errorf("GetUserLimits err: %v", err)
+		return nil, utils.RichError(codes.Internal, "LIMIT_READ_FAILED", err.Error())
+	}
+	effectiveLimit, err := utils.GetEffectiveUserLimit(in.UserId, account, qosMap)
+	if err != nil {
+		logrus.Errorf("GetUserLimits err: %v", err)
+		return nil, utils.RichError(codes.Internal, "LIMIT_READ_FAILED", err.Error())
+	}
+
+	logrus.Tracef("GetUserLimits user: %v account: %v limit: %v effective: %v", in.UserId, in.AccountName, limit, effectiveLimit)
+	return &adapterProtos.GetUserLimitsResponse{
+		Limits:          fromResourceLimit(limit),
+		EffectiveLimits: fromResourceLimit(effectiveLimit),
+	}, nil
+}
+
+func (s *ServerAccount) ListEffectiveLimits(ctx context.Context, in *adapterProtos.ListEffectiveLimitsRequest) (*adapterProtos.ListEffectiveLimitsResponse, error) {
+	logrus.Infof("Received request ListEffectiveLimits: %v", in)
+
+	accountsWithUsers, err := utils.GetAccountsWithUsers(ctx, in.AccountNames)
+	if err != nil {
+		logrus.Errorf("ListEffectiveLimits err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+	qosMap, err := utils.GetQosInfoMap(ctx)
+	if err != nil {
+		logrus.Errorf("ListEffectiveLimits err: %v", err)
+		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
+	}
+
+	response := &adapterProtos.ListEffectiveLimitsResponse{}
+	for _, accountUsers := range accountsWithUsers {
+		accountLimit, err := utils.GetEffectiveAccountLimit(accountUsers.Account, qosMap)
+		if err != nil {
+			logrus.Errorf("ListEffectiveLimits err: %v", err)
+			return nil, utils.RichError(codes.Internal, "LIMIT_READ_FAILED", err.Error())
+		}
+		accountLimits := &adapterProtos.ListEffectiveLimitsResponse_AccountLimits{
+			AccountName:     accountUsers.Account.GetName(),
+			EffectiveLimits: fromResourceLimit(accountLimit),
+		}
+		for _, user := range accountUsers.Users {
+			userLimit, err := utils.GetEffectiveUserLimit(user.GetName(), accountUsers.Account, qosMap)
+			if err != nil {
+				logrus.Errorf("ListEffectiveLimits err: %v", err)
+				return nil, utils.RichError(codes.Internal, "LIMIT_READ_FAILED", err.Error())
+			}
+			accountLimits.Users = append(accountLimits.Users, &adapterProtos.ListEffectiveLimitsResponse_UserLimits{
+				UserId:          user.GetName(),
+				EffectiveLimits: fromResourceLimit(userLimit),
+			})
+		}
+		response.Accounts = append(response.Accounts, accountLimits)
+	}
+
+	logrus.Tracef("ListEffectiveLimits response: %v", response.Accounts)
+	return response, nil
+}
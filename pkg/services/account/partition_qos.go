@@ -0,0 +1,111 @@
+package account
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	"scow-crane-adapter/pkg/utils"
+)
+
+func (s *ServerAccount) GrantAccountPartitions(ctx context.Context, in *adapterProtos.GrantAccountPartitionsRequest) (*adapterProtos.GrantAccountPartitionsResponse, error) {
+	logrus.Infof("Received request GrantAccountPartitions: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("GrantAccountPartitions failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	if len(in.Partitions) == 0 {
+		logrus.Infof("GrantAccountPartitions: %v no partition need grant", in.AccountName)
+		return &adapterProtos.GrantAccountPartitionsResponse{}, nil
+	}
+
+	if err := utils.GrantAccountPartitions(ctx, in.AccountName, in.Partitions); err != nil {
+		logrus.Errorf("GrantAccountPartitions err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+
+	logrus.Infof("GrantAccountPartitions account: %v partitions: %v success", in.AccountName, in.Partitions)
+	return &adapterProtos.GrantAccountPartitionsResponse{}, nil
+}
+
+func (s *ServerAccount) RevokeAccountPartitions(ctx context.Context, in *adapterProtos.RevokeAccountPartitionsRequest) (*adapterProtos.RevokeAccountPartitionsResponse, error) {
+	logrus.Infof("Received request RevokeAccountPartitions: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("RevokeAccountPartitions failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	if len(in.Partitions) == 0 {
+		logrus.Infof("RevokeAccountPartitions: %v no partition need revoke", in.AccountName)
+		return &adapterProtos.RevokeAccountPartitionsResponse{}, nil
+	}
+
+	if err := utils.RevokeAccountPartitions(ctx, in.AccountName, in.Partitions); err != nil {
+		logrus.Errorf("RevokeAccountPartitions err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+
+	logrus.Infof("RevokeAccountPartitions account: %v partitions: %v success", in.AccountName, in.Partitions)
+	return &adapterProtos.RevokeAccountPartitionsResponse{}, nil
+}
+
+func (s *ServerAccount) SetAccountQos(ctx context.Context, in *adapterProtos.SetAccountQosRequest) (*adapterProtos.SetAccountQosResponse, error) {
+	logrus.Infof("Received request SetAccountQos: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("SetAccountQos failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	if len(in.AllowedQosList) == 0 {
+		logrus.Errorf("SetAccountQos failed: account %v allowed qos list is empty", in.AccountName)
+		return nil, utils.RichError(codes.InvalidArgument, "QOS_ILLEGAL", "allowed qos list is empty")
+	}
+
+	if err := utils.SetAccountQos(ctx, in.AccountName, in.AllowedQosList, in.GetDefaultQos()); err != nil {
+		logrus.Errorf("SetAccountQos err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+
+	logrus.Infof("SetAccountQos account: %v success", in.AccountName)
+	return &adapterProtos.SetAccountQosResponse{}, nil
+}
+
+func (s *ServerAccount) GetAccountPartitionQos(ctx context.Context, in *adapterProtos.GetAccountPartitionQosRequest) (*adapterProtos.GetAccountPartitionQosResponse, error) {
+	logrus.Infof("Received request GetAccountPartitionQos: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("GetAccountPartitionQos failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	account, err := utils.GetAccountByName(ctx, in.AccountName)
+	if err != nil {
+		logrus.Errorf("GetAccountPartitionQos err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+
+	grant, err := utils.GetPartitionGrant(ctx, account)
+	if err != nil {
+		logrus.Errorf("GetAccountPartitionQos err: %v", err)
+		return nil, utils.RichError(codes.Internal, "PARTITION_GRANT_READ_FAILED", err.Error())
+	}
+
+	logrus.Tracef("GetAccountPartitionQos account: %v grant: %v", in.AccountName, grant)
+	return &adapterProtos.GetAccountPartitionQosResponse{
+		AccountName:       account.GetName(),
+		GrantedPartitions: grant.Granted,
+		BlockedPartitions: grant.Blocked,
+		AllowedQosList:    account.GetAllowedQosList(),
+		DefaultQos:        account.GetDefaultQos(),
+	}, nil
+}
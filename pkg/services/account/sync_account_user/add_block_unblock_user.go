@@ -1,6 +1,7 @@
 package sync_account_user
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/sirupsen/logrus"
@@ -10,13 +11,13 @@ import (
 )
 
 // AddAndBlockUserInAccount 同步创建用户，然后需要的话封锁用户
-func AddAndBlockUserInAccount(users []*pb.SyncAccountInfo_UserInAccount, accountName string) []*pb.SyncAccountUserInfoResponse_SyncOperationResult {
+func AddAndBlockUserInAccount(ctx context.Context, users []*pb.SyncAccountInfo_UserInAccount, accountName string) []*pb.SyncAccountUserInfoResponse_SyncOperationResult {
 	var (
 		results []*pb.SyncAccountUserInfoResponse_SyncOperationResult
 		message string
 	)
 
-	userBlockedInfo, err := utils.GetAccountUserBlockedInfo(accountName)
+	userBlockedInfo, err := utils.GetAccountUserBlockedInfo(ctx, accountName)
 	if err != nil {
 		message = fmt.Sprintf("add user in account, get associate info in database failed: %v", err)
 		logrus.Errorf("[SyncAccountUser] %v", message)
@@ -33,12 +34,12 @@ func AddAndBlockUserInAccount(users []*pb.SyncAccountInfo_UserInAccount, account
 		blocked, exitAssociate := userBlockedInfo[user.UserId]
 		if exitAssociate {
 			// 存在关联关系，封锁或解封用户用户
-			if result := blockOrUnblockUser(user, accountName, blocked); result != nil {
+			if result := blockOrUnblockUser(ctx, user, accountName, blocked); result != nil {
 				results = append(results, result)
 			}
 		} else {
 			// 不存在关联关系，先将用户加入账户
-			if err = utils.AddUserToAccount(accountName, user.UserId); err != nil {
+			if err = utils.AddUserToAccount(ctx, accountName, user.UserId); err != nil {
 				message = fmt.Sprintf("add user %v to account %v failed: %v", user.UserId, accountName, err)
 				logrus.Errorf("[SyncAccountUser] %v", message)
 				results = append(results, AddUserToAccountFailedOperation(accountName, user.UserId, message))
@@ -51,7 +52,7 @@ func AddAndBlockUserInAccount(users []*pb.SyncAccountInfo_UserInAccount, account
 
 			// 封锁用户
 			if user.Blocked {
-				err = utils.BlockUserInAccount(user.UserId, accountName)
+				err = utils.BlockUserInAccount(ctx, user.UserId, accountName)
 				if err != nil {
 					message = fmt.Sprintf("add user success, but block user %v in account %v failed: %v", user.UserId, accountName, err)
 					logrus.Errorf("[SyncAccountUser]: %v", message)
@@ -67,10 +68,10 @@ func AddAndBlockUserInAccount(users []*pb.SyncAccountInfo_UserInAccount, account
 	return results
 }
 
-func blockOrUnblockUser(user *pb.SyncAccountInfo_UserInAccount, accountName string, blocked bool) *pb.SyncAccountUserInfoResponse_SyncOperationResult {
+func blockOrUnblockUser(ctx context.Context, user *pb.SyncAccountInfo_UserInAccount, accountName string, blocked bool) *pb.SyncAccountUserInfoResponse_SyncOperationResult {
 	// 封锁用户
 	if user.Blocked && !blocked {
-		if err := utils.BlockUserInAccount(user.UserId, accountName); err != nil {
+		if err := utils.BlockUserInAccount(ctx, user.UserId, accountName); err != nil {
 			message := fmt.Sprintf("block user %v in account %v failed: %v", user.UserId, accountName, err)
 			logrus.Errorf("[SyncAccountUser]: %v", message)
 			return BlockUserInAccountFailedOperation(accountName, user.UserId, message)
@@ -82,7 +83,7 @@ func blockOrUnblockUser(user *pb.SyncAccountInfo_UserInAccount, accountName stri
 
 	// 解封用户
 	if !user.Blocked && blocked {
-		if err := utils.UnblockUserInAccount(user.UserId, accountName); err != nil {
+		if err := utils.UnblockUserInAccount(ctx, user.UserId, accountName); err != nil {
 			message := fmt.Sprintf("unblock user %v in account %v failed: %v", user.UserId, accountName, err)
 			logrus.Errorf("[SyncAccountUser]: %v", message)
 			return UnblockUserInAccountFailedOperation(accountName, user.UserId, message)
@@ -1,6 +1,7 @@
 package sync_account_user
 
 import (
+	"context"
 	"fmt"
 	"github.com/sirupsen/logrus"
 	pb "scow-crane-adapter/gen/go"
@@ -8,21 +9,21 @@ import (
 )
 
 // 同步账户的封锁情况
-func syncAccountBlockStatus(syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoResponse_SyncOperationResult {
+func syncAccountBlockStatus(ctx context.Context, syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoResponse_SyncOperationResult {
 	var result *pb.SyncAccountUserInfoResponse_SyncOperationResult
 
 	// 同步账户的封锁
 	if syncData.BlockedInCluster {
-		result = BlockAccount(syncData)
+		result = BlockAccount(ctx, syncData)
 	} else {
 		// 同步账户的解封
-		result = UnBlockAccount(syncData)
+		result = UnBlockAccount(ctx, syncData)
 	}
 
 	return result
 }
 
-func BlockAccount(syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoResponse_SyncOperationResult {
+func BlockAccount(ctx context.Context, syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoResponse_SyncOperationResult {
 	if syncData.WhitelistId != nil {
 		message := fmt.Sprintf("The account is in the whitelist and does not need to be blocked")
 		logrus.Infof("[SyncAccountUser], %v", message)
@@ -30,7 +31,7 @@ func BlockAccount(syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoResponse_
 	}
 
 	// 先查询账户
-	account, err := utils.GetAccountByName(syncData.AccountName)
+	account, err := utils.GetAccountByName(ctx, syncData.AccountName)
 	if err != nil {
 		message := fmt.Sprintf("get account: %v failed", syncData.AccountName)
 		logrus.Errorf("[SyncAccountUser], %v", message)
@@ -42,7 +43,7 @@ func BlockAccount(syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoResponse_
 		return nil
 	}
 
-	if err := utils.BlockAccount(syncData.AccountName); err != nil {
+	if err := utils.BlockAccount(ctx, syncData.AccountName); err != nil {
 		message := fmt.Sprintf("block account: %v failed", syncData.AccountName)
 		logrus.Errorf("[SyncAccountUser], %v", message)
 		return BlockAccountFailedOperation(syncData.AccountName, message)
@@ -53,16 +54,16 @@ func BlockAccount(syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoResponse_
 	return BlockAccountSuccessOperation(syncData.AccountName)
 }
 
-func UnBlockAccount(syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoResponse_SyncOperationResult {
+func UnBlockAccount(ctx context.Context, syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoResponse_SyncOperationResult {
 	var message string
 
 	// 获取unblockedPartitions分区，该分区需要解封, blockPartitions分区，该分区需要封锁
-	unblockPartition, blockPartitions := getBlockAndUnblockPartition(syncData)
+	unblockPartition, blockPartitions := getBlockAndUnblockPartition(ctx, syncData)
 	logrus.Infof("unblock partitions: %v, block partitions: %v", unblockPartition, blockPartitions)
 
 	// 解封分区
 	// 先查询账户
-	account, err := utils.GetAccountByName(syncData.AccountName)
+	account, err := utils.GetAccountByName(ctx, syncData.AccountName)
 	if err != nil {
 		message = fmt.Sprintf("get account %v failed: %v", syncData.AccountName, err)
 		logrus.Errorf("[SyncAccountUser] %v", message)
@@ -73,7 +74,7 @@ func UnBlockAccount(syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoRespons
 	if len(unblockPartition) > 0 && account.Blocked {
 		executeUnblock = true
 		// 先将账户的Blocked字段置为false
-		if err = utils.UnblockAccount(account.Name); err != nil {
+		if err = utils.UnblockAccount(ctx, account.Name); err != nil {
 			message = fmt.Sprintf("unblock account %v failed: %v", syncData.AccountName, err)
 			logrus.Errorf("[SyncAccountUser] %v", message)
 			return UnblockAccountFailedOperation(syncData.AccountName, message)
@@ -84,10 +85,18 @@ func UnBlockAccount(syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoRespons
 	allowPartitions := account.GetAllowedPartitions()
 	logrus.Infof("allow Partitions: %v", allowPartitions)
 
+	// 获取账户的分区授予记录，未授予的分区不需要解封
+	grant, err := utils.GetPartitionGrant(ctx, account)
+	if err != nil {
+		message = fmt.Sprintf("get partition grant of account %v failed: %v", syncData.AccountName, err)
+		logrus.Errorf("[SyncAccountUser] %v", message)
+		return UnblockAccountFailedOperation(syncData.AccountName, message)
+	}
+
 	var needUnblockPartitions []string
 	// 需要解封的分区不在账户的allowPartitions内，表示账户在该分区是封锁状态，需进行解封
 	for _, partition := range unblockPartition {
-		if !utils.Contains(allowPartitions, partition) {
+		if !utils.Contains(allowPartitions, partition) && utils.Contains(grant.Granted, partition) {
 			needUnblockPartitions = append(needUnblockPartitions, partition)
 		}
 	}
@@ -103,7 +112,7 @@ func UnBlockAccount(syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoRespons
 	if len(needUnblockPartitions) != 0 {
 		executeUnblock = true
 		logrus.Infof("need Unblock Partitions: %v", needUnblockPartitions)
-		if err := utils.UnblockAccountWithPartition(syncData.AccountName, unblockPartition); err != nil {
+		if err := utils.UnblockAccountWithPartition(ctx, syncData.AccountName, unblockPartition); err != nil {
 			message = fmt.Sprintf("unblock account %v in partitions %v failed: %v", syncData.AccountName, unblockPartition, err)
 			logrus.Errorf("[SyncAccountUser] %v", message)
 			return UnblockAccountFailedOperation(syncData.AccountName, message)
@@ -114,7 +123,7 @@ func UnBlockAccount(syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoRespons
 	if len(needBlockPartitions) != 0 {
 		executeBlock = true
 		logrus.Infof("need Block Partitions: %v", needBlockPartitions)
-		if err := utils.BlockAccountWithPartition(syncData.AccountName, blockPartitions); err != nil {
+		if err := utils.BlockAccountWithPartition(ctx, syncData.AccountName, blockPartitions); err != nil {
 			message = fmt.Sprintf("block account %v in partitions %v failed: %v", syncData.AccountName, blockPartitions, err)
 			logrus.Errorf("[SyncAccountUser] %v", message)
 			return UnblockAccountFailedOperation(syncData.AccountName, message)
@@ -129,13 +138,13 @@ func UnBlockAccount(syncData *pb.SyncAccountInfo) *pb.SyncAccountUserInfoRespons
 	return nil
 }
 
-func getBlockAndUnblockPartition(syncData *pb.SyncAccountInfo) ([]string, []string) {
+func getBlockAndUnblockPartition(ctx context.Context, syncData *pb.SyncAccountInfo) ([]string, []string) {
 	var (
 		blockPartitions   []string
 		unblockPartitions []string
 	)
 
-	partitions := utils.GetAllPartitions()
+	partitions := utils.GetAllPartitions(ctx)
 	if syncData.GetUseAllPartitions() {
 		unblockPartitions = partitions
 		blockPartitions = []string{}
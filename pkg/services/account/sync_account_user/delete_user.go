@@ -1,6 +1,7 @@
 package sync_account_user
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/sirupsen/logrus"
@@ -10,7 +11,7 @@ import (
 )
 
 // DeleteUserInAccount 同步删除用户
-func DeleteUserInAccount(users []*pb.SyncAccountInfo_UserInAccount, accountName string) []*pb.SyncAccountUserInfoResponse_SyncOperationResult {
+func DeleteUserInAccount(ctx context.Context, users []*pb.SyncAccountInfo_UserInAccount, accountName string) []*pb.SyncAccountUserInfoResponse_SyncOperationResult {
 	var (
 		responseInfo    []*pb.SyncAccountUserInfoResponse_SyncOperationResult
 		message         string
@@ -22,7 +23,7 @@ func DeleteUserInAccount(users []*pb.SyncAccountInfo_UserInAccount, accountName
 	}
 
 	// 得到实际环境有而同步数据中没有的用户
-	deleteUsers, err := utils.GetAccountAssociatedUser(accountName, excludeUserList)
+	deleteUsers, err := utils.GetAccountAssociatedUser(ctx, accountName, excludeUserList)
 	if err != nil {
 		message = fmt.Sprintf("remove user from account, get need delete user failed: %v", err)
 		logrus.Errorf("[SyncAccountUser] %v", message)
@@ -33,7 +34,7 @@ func DeleteUserInAccount(users []*pb.SyncAccountInfo_UserInAccount, accountName
 	// 删除用户
 	for _, user := range deleteUsers {
 		// 获取用户未结束的作业列表
-		hasJob, err := utils.HasUnfinishedJobsByUserName(user)
+		hasJob, err := utils.HasUnfinishedJobsByUserName(ctx, user)
 		if err != nil {
 			message = fmt.Sprintf("remove user %v from account %v, get not completed jobs failed: %v", user, accountName, err)
 			logrus.Errorf("[SyncAccountUser] %v", message)
@@ -51,7 +52,7 @@ func DeleteUserInAccount(users []*pb.SyncAccountInfo_UserInAccount, accountName
 		}
 
 		// 从账户中移除用户
-		if err = utils.DeleteUserFromAccount(user, accountName); err != nil {
+		if err = utils.DeleteUserFromAccount(ctx, user, accountName); err != nil {
 			message = fmt.Sprintf("remove user %v from account %v, delete associate failed: %v", user, accountName, err)
 			logrus.Errorf("[SyncAccountUser] %v", message)
 			responseInfo = append(responseInfo, RemoveUserFromAccountFailedOperation(accountName, user, message))
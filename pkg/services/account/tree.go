@@ -0,0 +1,59 @@
+package account
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	"scow-crane-adapter/pkg/utils"
+)
+
+func (s *ServerAccount) ListAccountTree(ctx context.Context, in *adapterProtos.ListAccountTreeRequest) (*adapterProtos.ListAccountTreeResponse, error) {
+	logrus.Infof("Received request ListAccountTree: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.GetRootAccount()); err != nil {
+		logrus.Errorf("ListAccountTree failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	roots, err := utils.GetAccountTree(ctx, in.GetRootAccount())
+	if err != nil {
+		logrus.Errorf("ListAccountTree err: %v", err)
+		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
+	}
+	if in.GetRootAccount() != "" && len(roots) == 0 {
+		logrus.Errorf("ListAccountTree failed: account %v not exists", in.GetRootAccount())
+		return nil, utils.RichError(codes.NotFound, "ACCOUNT_NOT_FOUND", "The account does not exists.")
+	}
+
+	var treeRoots []*adapterProtos.AccountTreeNode
+	for _, root := range roots {
+		treeRoots = append(treeRoots, toAccountTreeNode(root))
+	}
+
+	logrus.Tracef("ListAccountTree response: %v", treeRoots)
+	return &adapterProtos.ListAccountTreeResponse{Roots: treeRoots}, nil
+}
+
+func toAccountTreeNode(node *utils.AccountTreeNode) *adapterProtos.AccountTreeNode {
+	treeNode := &adapterProtos.AccountTreeNode{
+		AccountName: node.Account.GetName(),
+		Description: node.Account.GetDescription(),
+		Blocked:     node.Account.GetBlocked(),
+	}
+	for _, child := range node.Children {
+		treeNode.Children = append(treeNode.Children, toAccountTreeNode(child))
+	}
+	return treeNode
+}
+
+func unblockAccountError(err error) error {
+	if errors.Is(err, utils.ErrParentAccountBlocked) {
+		return utils.RichError(codes.FailedPrecondition, "PARENT_ACCOUNT_BLOCKED", err.Error())
+	}
+	return utils.ServiceError(err)
+}
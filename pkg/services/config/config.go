@@ -19,13 +19,13 @@ func (s *ServerConfig) GetClusterConfig(ctx context.Context, in *protos.GetClust
 	logrus.Infof("Received request GetClusterConfig: %v", in)
 
 	// 获取系统Qos
-	qosList, err := utils.GetAllQos()
+	qosList, err := utils.GetAllQos(ctx)
 	if err != nil {
 		logrus.Errorf("GetClusterConfig Error getting QoS: %v", err)
 		return nil, utils.RichError(codes.Internal, "Error getting QoS", err.Error())
 	}
 
-	partitions, err = utils.GetCraneClusterConfig(nil, qosList)
+	partitions, err = utils.GetCraneClusterConfig(ctx, nil, qosList)
 	if err != nil {
 		logrus.Errorf("GetClusterConfig error: %v", err)
 		return nil, err
@@ -40,7 +40,7 @@ func (s *ServerConfig) GetAvailablePartitions(ctx context.Context, in *protos.Ge
 	var partitions []*protos.Partition
 
 	// 获取账户信息
-	account, err := utils.GetAccountByName(in.AccountName)
+	account, err := utils.GetAccountByName(ctx, in.AccountName)
 	if err != nil {
 		logrus.Errorf("GetAvailablePartitions err: %v", err)
 		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
@@ -62,7 +62,7 @@ func (s *ServerConfig) GetAvailablePartitions(ctx context.Context, in *protos.Ge
 	// 获取账户的allowQos
 	allowQos := account.GetAllowedQosList()
 
-	partitions, err = utils.GetCraneClusterConfig(allowPartitions, allowQos)
+	partitions, err = utils.GetCraneClusterConfig(ctx, allowPartitions, allowQos)
 	if err != nil {
 		logrus.Errorf("GetAvailablePartitions err: %v", err)
 		return nil, err
@@ -77,10 +77,10 @@ func (s *ServerConfig) GetClusterNodesInfo(ctx context.Context, in *protos.GetCl
 	logrus.Infof("Received request GetClusterNodesInfo: %v", in)
 
 	request := &craneProtos.QueryCranedInfoRequest{}
-	info, err := utils.CraneCtld.QueryCranedInfo(context.Background(), request)
+	info, err := utils.CraneCtld.QueryCranedInfo(ctx, request)
 	if err != nil {
 		logrus.Errorf("GetClusterNodesInfo failed: %v", err)
-		return nil, err
+		return nil, utils.CraneCallError(err)
 	}
 
 	logrus.Tracef("GetClusterNodesInfo nodeInfo%v", info.GetCranedInfoList())
@@ -98,44 +98,43 @@ func (s *ServerConfig) GetClusterNodesInfo(ctx context.Context, in *protos.GetCl
 func (s *ServerConfig) GetClusterInfo(ctx context.Context, in *protos.GetClusterInfoRequest) (*protos.GetClusterInfoResponse, error) {
 	var partitions []*protos.PartitionInfo
 	logrus.Infof("Received request GetClusterInfo: %v", in)
-	for _, part := range utils.CConfig.Partitions { // 遍历每个计算分区、分别获取信息  分区从接口获取
-		var state protos.PartitionInfo_PartitionStatus
-		// 根据分区名获取分区信息
-		partitionName := part.Name
 
-		partitionInfo, err := utils.GetPartitionByName(partitionName)
-		if err != nil {
-			logrus.Errorf("GetClusterInfo failed: %v", err)
-			return nil, utils.RichError(codes.Internal, "GetClusterInfo failed", err.Error())
+	// 一次并发查询所有分区、节点和作业，再按分区聚合
+	snapshot, err := utils.GetClusterSnapshot(ctx)
+	if err != nil {
+		logrus.Errorf("GetClusterInfo failed: %v", err)
+		return nil, utils.RichError(codes.Internal, "GetClusterInfo failed", err.Error())
+	}
+	nodeCounts := snapshot.NodeCountByPartition()
+	jobCounts := snapshot.JobCountByPartition()
+
+	for _, partitionName := range snapshot.PartitionNames() { // 遍历每个计算分区、分别获取信息  分区从快照获取
+		var state protos.PartitionInfo_PartitionStatus
+		partitionInfo, ok := snapshot.Partitions[partitionName]
+		if !ok {
+			logrus.Warnf("GetClusterInfo partition %v not found", partitionName)
+			continue
 		}
 		logrus.Tracef("GetClusterInfo partition info: %v", partitionInfo)
 
-		// 获取正在运行作业的个数
-		runningJob, err := utils.GetTaskByPartitionAndStatus([]string{partitionName}, []craneProtos.TaskStatus{craneProtos.TaskStatus_Running})
-		if err != nil {
-			logrus.Errorf("GetClusterInfo failed: %v", err)
-			return nil, utils.RichError(codes.Internal, "CRANE_RUNCOMMAND_ERROR", err.Error())
+		var runningJobNum, pendingJobNum uint32
+		if jobs, ok := jobCounts[partitionName]; ok {
+			runningJobNum = jobs.RunningJobCount
+			pendingJobNum = jobs.PendingJobCount
 		}
-		runningJobNum := len(runningJob)
 
-		// 获取正在排队作业的个数
-		pendingJob, err := utils.GetTaskByPartitionAndStatus([]string{partitionName}, []craneProtos.TaskStatus{craneProtos.TaskStatus_Pending})
-		if err != nil {
-			logrus.Errorf("GetClusterInfo failed: %v", err)
-			return nil, utils.RichError(codes.Internal, "CRANE_RUNCOMMAND_ERROR", err.Error())
+		nodes, ok := nodeCounts[partitionName]
+		if !ok {
+			nodes = &utils.PartitionNodeCount{}
 		}
-		pendingJobNum := len(pendingJob)
+		logrus.Tracef("GetClusterInfo partition %v node count: %+v", partitionName, nodes)
 
-		idleNodeCount, allocNodeCount, mixNodeCount, downNodeCount, err := utils.GetNodeByPartition([]string{partitionName})
-		if err != nil {
-			logrus.Errorf("GetClusterInfo failed: %v", err)
-			return nil, utils.RichError(codes.Internal, "CRANE_RUNCOMMAND_ERROR", err.Error())
+		runningNodes := nodes.Alloc + nodes.Mix
+		var percentage int
+		if partitionInfo.GetTotalNodes() > 0 {
+			resultRatio := float64(runningNodes) / float64(partitionInfo.GetTotalNodes())
+			percentage = int(resultRatio * 100) // 保留整数
 		}
-		logrus.Tracef("GetClusterInfo idleNodeCount, allocNodeCount, mixNodeCount, downNodeCount: %v, %v, %v, %v", idleNodeCount, allocNodeCount, mixNodeCount, downNodeCount)
-
-		runningNodes := allocNodeCount + mixNodeCount
-		resultRatio := float64(runningNodes) / float64(partitionInfo.GetTotalNodes())
-		percentage := int(resultRatio * 100) // 保留整数
 		if partitionInfo.GetState() == craneProtos.PartitionState_PARTITION_UP {
 			state = protos.PartitionInfo_AVAILABLE
 		} else {
@@ -157,7 +156,7 @@ func (s *ServerConfig) GetClusterInfo(ctx context.Context, in *protos.GetCluster
 			PartitionName:         partitionInfo.GetName(),
 			NodeCount:             partitionInfo.GetTotalNodes(),
 			RunningNodeCount:      runningNodes,
-			IdleNodeCount:         idleNodeCount,
+			IdleNodeCount:         nodes.Idle,
 			NotAvailableNodeCount: partitionInfo.GetTotalNodes() - partitionInfo.GetAliveNodes(),
 			CpuCoreCount:          uint32(TotalCpu),
 			RunningCpuCount:       uint32(AllocCpu),
@@ -167,15 +166,18 @@ func (s *ServerConfig) GetClusterInfo(ctx context.Context, in *protos.GetCluster
 			RunningGpuCount:       AllocGpu,
 			IdleGpuCount:          IdleGpu,
 			NotAvailableGpuCount:  NotAvailableGpu,
-			JobCount:              uint32(runningJobNum) + uint32(pendingJobNum),
-			RunningJobCount:       uint32(runningJobNum),
-			PendingJobCount:       uint32(pendingJobNum),
+			JobCount:              runningJobNum + pendingJobNum,
+			RunningJobCount:       runningJobNum,
+			PendingJobCount:       pendingJobNum,
 			UsageRatePercentage:   uint32(percentage),
 			PartitionStatus:       state,
 		})
 
 	}
 
+	if err = setClusterInfoPartialHeader(ctx, snapshot.Partial); err != nil {
+		logrus.Warnf("GetClusterInfo set partial header failed: %v", err)
+	}
 	logrus.Tracef("GetClusterInfo Partitions info: %v", partitions)
 	return &protos.GetClusterInfoResponse{ClusterName: utils.CConfig.ClusterName, Partitions: partitions}, nil
 }
@@ -185,7 +187,7 @@ func (s *ServerConfig) GetSummaryClusterInfo(ctx context.Context, in *protos.Get
 
 	clusterName := utils.CConfig.ClusterName
 
-	authorizedPartitions, err := utils.GetAccountsAuthorizedPartitions(in.AccountNames)
+	authorizedPartitions, err := utils.GetAccountsAuthorizedPartitions(ctx, in.AccountNames)
 	if err != nil {
 		logrus.Errorf("GetSummaryClusterInfo failed: %v", err)
 		return nil, utils.RichError(codes.Internal, "GET_ACCOUNT_ALLOW_PARTITIONS_FAILED", err.Error())
@@ -197,17 +199,18 @@ func (s *ServerConfig) GetSummaryClusterInfo(ctx context.Context, in *protos.Get
 		return nil, utils.RichError(codes.Internal, "ACCOUNT_WITHOUT_ALLOW_PARTITIONS", err.Error())
 	}
 
-	// 获取整个集群的nodesInfo
-	scni, err := utils.GetSummaryClusterNodesInfo(authorizedPartitions)
+	snapshot, err := utils.GetClusterSnapshot(ctx)
 	if err != nil {
 		logrus.Errorf("Failed Get Cluster Info, error: %v", err)
 		return nil, utils.RichError(codes.Internal, "COMMAND_EXECUTE_FAILED", err.Error())
 	}
 
-	summaryPartitions, err := utils.GetSummaryPartitionsInfo(authorizedPartitions)
-	if err != nil {
-		logrus.Errorf("Failed Get Cluster Info, error: %v", err)
-		return nil, utils.RichError(codes.Internal, "COMMAND_EXECUTE_FAILED", err.Error())
+	// 获取整个集群的nodesInfo
+	scni := utils.GetSummaryClusterNodesInfo(ctx, snapshot, authorizedPartitions)
+	summaryPartitions := utils.GetSummaryPartitionsInfo(ctx, snapshot, authorizedPartitions)
+
+	if err = setClusterInfoPartialHeader(ctx, snapshot.Partial); err != nil {
+		logrus.Warnf("GetSummaryClusterInfo set partial header failed: %v", err)
 	}
 
 	return &protos.GetSummaryClusterInfoResponse{
@@ -241,3 +244,11 @@ func (s *ServerConfig) ListImplementedOptionalFeatures(ctx context.Context, in *
 
 	return &protos.ListImplementedOptionalFeaturesResponse{Features: features}, nil
 }
+
+func partitionNames(partitions []*protos.Partition) []string {
+	var names []string
+	for _, partition := range partitions {
+		names = append(names, partition.GetName())
+	}
+	return names
+}
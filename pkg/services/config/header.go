@@ -0,0 +1,19 @@
+package config
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ClusterInfoPartialHeader GetClusterInfo和GetSummaryClusterInfo的结果不完整时返回的响应头
+const ClusterInfoPartialHeader = "cluster-info-partial"
+
+// setClusterInfoPartialHeader 节点或作业的查询超时、失败时标记结果不完整，调用方据此提示统计可能偏小
+func setClusterInfoPartialHeader(ctx context.Context, partial bool) error {
+	if !partial {
+		return nil
+	}
+	return grpc.SetHeader(ctx, metadata.Pairs(ClusterInfoPartialHeader, "true"))
+}
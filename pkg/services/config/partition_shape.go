@@ -0,0 +1,58 @@
+package config
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	"scow-crane-adapter/pkg/utils"
+)
+
+func (s *ServerConfig) GetPartitionShapes(ctx context.Context, in *adapterProtos.GetPartitionShapesRequest) (*adapterProtos.GetPartitionShapesResponse, error) {
+	logrus.Infof("Received request GetPartitionShapes: %v", in)
+
+	var (
+		allowPartitions []string
+		qosList         []string
+		defaultQos      string
+		err             error
+	)
+	if in.AccountName != nil {
+		// 检查账户名
+		if err = utils.CheckAccount(in.GetAccountName()); err != nil {
+			logrus.Errorf("GetPartitionShapes failed: %v", err)
+			return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+		}
+		account, err := utils.GetAccountByName(ctx, in.GetAccountName())
+		if err != nil {
+			logrus.Errorf("GetPartitionShapes err: %v", err)
+			return nil, utils.ServiceError(err)
+		}
+		allowPartitions = account.GetAllowedPartitions()
+		qosList = account.GetAllowedQosList()
+		defaultQos = account.GetDefaultQos()
+	} else {
+		if qosList, err = utils.GetAllQos(ctx); err != nil {
+			logrus.Errorf("GetPartitionShapes Error getting QoS: %v", err)
+			return nil, utils.CraneCallError(err)
+		}
+		// 集群没有默认qos，与创建账户时一样以第一个qos为默认qos
+		defaultQos = qosList[0]
+	}
+
+	partitions, err := utils.GetCraneClusterConfig(ctx, allowPartitions, qosList)
+	if err != nil {
+		logrus.Errorf("GetPartitionShapes err: %v", err)
+		return nil, err
+	}
+	shapes, err := utils.GetPartitionShapes(ctx, partitionNames(partitions), qosList, defaultQos)
+	if err != nil {
+		logrus.Errorf("GetPartitionShapes err: %v", err)
+		return nil, utils.CraneCallError(err)
+	}
+
+	logrus.Tracef("GetPartitionShapes response: %v", shapes)
+	return &adapterProtos.GetPartitionShapesResponse{Partitions: shapes}, nil
+}
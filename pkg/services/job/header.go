@@ -0,0 +1,52 @@
+package job
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// scow定义的作业消息无法增加字段，预留通过以下请求头和响应头传递
+const (
+	// JobReservationHeader SubmitJob传入预留名称的请求头
+	JobReservationHeader = "job-reservation"
+	// JobReservationsHeader GetJobById和GetJobs返回作业所用预留的响应头
+	JobReservationsHeader = "job-reservations-bin"
+)
+
+// getJobReservation 从请求头中获取作业使用的预留，未传入时返回空
+func getJobReservation(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(JobReservationHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// setJobReservationsHeader 将作业使用的预留放到响应头中返回，没有作业使用预留时不设置
+func setJobReservationsHeader(ctx context.Context, tasks []*craneProtos.TaskInfo) error {
+	reservations := make(map[uint32]string)
+	for _, task := range tasks {
+		if task.GetReservation() != "" {
+			reservations[task.GetTaskId()] = task.GetReservation()
+		}
+	}
+	if len(reservations) == 0 {
+		return nil
+	}
+
+	content, err := proto.Marshal(&adapterProtos.JobReservations{Reservations: reservations})
+	if err != nil {
+		return err
+	}
+	return grpc.SetHeader(ctx, metadata.Pairs(JobReservationsHeader, string(content)))
+}
@@ -3,6 +3,7 @@ package job
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
@@ -33,10 +34,10 @@ func (s *ServerJob) CancelJob(ctx context.Context, in *protos.CancelJobRequest)
 		FilterTaskIds: []uint32{uint32(in.JobId)},
 		FilterState:   craneProtos.TaskStatus_Invalid,
 	}
-	_, err := utils.CraneCtld.CancelTask(context.Background(), request)
+	_, err := utils.CraneCtld.CancelTask(ctx, request)
 	if err != nil {
 		logrus.Errorf("CancelJob failed: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", "Crane service call failed.")
+		return nil, utils.CraneCallError(err)
 	}
 	logrus.Infof("CancelJob job: %v success", in.JobId)
 	return &protos.CancelJobResponse{}, nil
@@ -54,10 +55,10 @@ func (s *ServerJob) QueryJobTimeLimit(ctx context.Context, in *protos.QueryJobTi
 		FilterTaskIds:               jobIdList,
 		OptionIncludeCompletedTasks: true, // 包含运行结束的作业
 	}
-	response, err := utils.CraneCtld.QueryTasksInfo(context.Background(), request)
+	response, err := utils.CraneCtld.QueryTasksInfo(ctx, request)
 	if err != nil {
 		logrus.Errorf("QueryJobTimeLimit failed: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 	taskInfoList := response.GetTaskInfoList()
 	if len(taskInfoList) == 0 {
@@ -88,10 +89,10 @@ func (s *ServerJob) ChangeJobTimeLimit(ctx context.Context, in *protos.ChangeJob
 		FilterTaskIds: jobIdList,
 	}
 
-	responseLimitTime, err := utils.CraneCtld.QueryTasksInfo(context.Background(), requestLimitTime)
+	responseLimitTime, err := utils.CraneCtld.QueryTasksInfo(ctx, requestLimitTime)
 	if err != nil {
 		logrus.Errorf("ChangeJobTimeLimit failed: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 
 	taskInfoList := responseLimitTime.GetTaskInfoList()
@@ -118,10 +119,10 @@ func (s *ServerJob) ChangeJobTimeLimit(ctx context.Context, in *protos.ChangeJob
 			TimeLimitSeconds: in.DeltaMinutes*60 + int64(seconds),
 		},
 	}
-	response, err := utils.CraneCtld.ModifyTask(context.Background(), request)
+	response, err := utils.CraneCtld.ModifyTask(ctx, request)
 	if err != nil {
 		logrus.Errorf("ChangeJobTimeLimit failed: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 	if len(response.GetNotModifiedTasks()) != 0 {
 		logrus.Errorf("ChangeJobTimeLimit failed: %v", fmt.Errorf("JOB_NOT_FOUND"))
@@ -142,10 +143,10 @@ func (s *ServerJob) GetJobById(ctx context.Context, in *protos.GetJobByIdRequest
 		FilterTaskIds:               []uint32{uint32(in.JobId)},
 		OptionIncludeCompletedTasks: true,
 	}
-	response, err := utils.CraneCtld.QueryTasksInfo(context.Background(), request)
+	response, err := utils.CraneCtld.QueryTasksInfo(ctx, request)
 	if err != nil {
 		logrus.Errorf("GetJobById failed: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 	if !response.GetOk() {
 		logrus.Errorf("GetJobById failed: %v", fmt.Errorf("CRANE_INTERNAL_ERROR"))
@@ -157,6 +158,9 @@ func (s *ServerJob) GetJobById(ctx context.Context, in *protos.GetJobByIdRequest
 	}
 	// 获取作业信息
 	TaskInfoList := response.GetTaskInfoList()[0]
+	if err = setJobReservationsHeader(ctx, response.GetTaskInfoList()); err != nil {
+		logrus.Warnf("GetJobById set reservation header failed: %v", err)
+	}
 	if TaskInfoList.GetStatus() == craneProtos.TaskStatus_Running {
 		elapsedSeconds = time.Now().Unix() - TaskInfoList.GetStartTime().Seconds
 	} else if TaskInfoList.GetStatus() == craneProtos.TaskStatus_Pending {
@@ -306,11 +310,11 @@ func (s *ServerJob) GetJobs(ctx context.Context, in *protos.GetJobsRequest) (*pr
 			NumLimit:                    99999999,
 		}
 	}
-	response, err := utils.CraneCtld.QueryTasksInfo(context.Background(), request)
+	response, err := utils.CraneCtld.QueryTasksInfo(ctx, request)
 
 	if err != nil {
 		logrus.Errorf("GetJobs failed: %v", fmt.Errorf("CRANE_CALL_FAILED"))
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 	if !response.GetOk() {
 		logrus.Errorf("GetJobs failed: %v", fmt.Errorf("CRANE_INTERNAL_ERROR"))
@@ -322,6 +326,9 @@ func (s *ServerJob) GetJobs(ctx context.Context, in *protos.GetJobsRequest) (*pr
 		return &protos.GetJobsResponse{Jobs: jobsInfo, TotalCount: &totalNum}, nil
 	}
 	totalNum = uint32(len(response.GetTaskInfoList()))
+	if err = setJobReservationsHeader(ctx, response.GetTaskInfoList()); err != nil {
+		logrus.Warnf("GetJobs set reservation header failed: %v", err)
+	}
 	for _, job := range response.GetTaskInfoList() {
 		var elapsedSeconds, timeLimitMinutes int64
 		var state string
@@ -517,7 +524,7 @@ func (s *ServerJob) SubmitJob(ctx context.Context, in *protos.SubmitJobRequest)
 	scriptString += "#CBATCH " + "-N " + strconv.Itoa(int(in.NodeCount)) + "\n"
 	scriptString += "#CBATCH " + "--ntasks-per-node " + strconv.Itoa(1) + "\n"
 	if in.GpuCount != 0 {
-		deviceType, err := utils.GetPartitionDeviceType(in.Partition)
+		deviceType, err := utils.GetPartitionDeviceType(ctx, in.Partition)
 		if err != nil {
 			logrus.Errorf("SubmitJob failed: %v", fmt.Errorf("CREATE_SCRIPT_FAILED"))
 			return nil, utils.RichError(codes.Aborted, "CREATE_SCRIPT_FAILED", "Create submit script failed.")
@@ -525,6 +532,22 @@ func (s *ServerJob) SubmitJob(ctx context.Context, in *protos.SubmitJobRequest)
 		scriptString += "#CBATCH " + "--gres " + deviceType + ":" + strconv.Itoa(int(in.GpuCount)) + "\n"
 	}
 	scriptString += "#CBATCH " + "-c " + strconv.Itoa(int(in.CoreCount)) + "\n"
+	// 使用预留中的节点
+	if reservationName := getJobReservation(ctx); reservationName != "" {
+		// 预留名称来自请求头，拼接到脚本前需要检查，避免注入其他参数
+		if err := utils.CheckReservationName(reservationName); err != nil {
+			logrus.Errorf("SubmitJob failed: %v", err)
+			return nil, utils.RichError(codes.InvalidArgument, "RESERVATION_ILLEGAL", err.Error())
+		}
+		if _, err := utils.GetReservation(ctx, reservationName); err != nil {
+			logrus.Errorf("SubmitJob failed: %v", err)
+			if errors.Is(err, utils.ErrReservationNotFound) {
+				return nil, utils.RichError(codes.NotFound, "RESERVATION_NOT_FOUND", err.Error())
+			}
+			return nil, utils.CraneCallError(err)
+		}
+		scriptString += "#CBATCH " + "--reservation " + reservationName + "\n"
+	}
 	if in.TimeLimitMinutes != nil {
 		// 要把时间换成字符串的形式
 		if *in.TimeLimitMinutes < 60 {
@@ -575,7 +598,7 @@ func (s *ServerJob) SubmitJob(ctx context.Context, in *protos.SubmitJobRequest)
 
 	os.Chmod(filePath, 0777)
 
-	submitResult, err := utils.LocalSubmitJob(filePath, in.UserId)
+	submitResult, err := utils.LocalSubmitJob(ctx, filePath, in.UserId)
 	os.Remove(filePath) // 删除掉提交脚本
 	if err != nil {
 		logrus.Errorf("SubmitJob failed: %v", err)
@@ -623,7 +646,7 @@ func (s *ServerJob) SubmitScriptAsJob(ctx context.Context, in *protos.SubmitScri
 	writer.WriteString(in.Script)
 	writer.Flush()
 
-	submitResult, err := utils.LocalSubmitJob(filePath, in.UserId)
+	submitResult, err := utils.LocalSubmitJob(ctx, filePath, in.UserId)
 	os.Remove(filePath) // 删除生成的提交脚本
 	if err != nil {
 		logrus.Errorf("SubmitScriptAsJob failed: %v", err)
@@ -646,10 +669,10 @@ func (s *ServerJob) RunCommandOnJobNodes(ctx context.Context, in *protos.RunComm
 		OptionIncludeCompletedTasks: true,
 	}
 
-	response, err := utils.CraneCtld.QueryTasksInfo(context.Background(), request)
+	response, err := utils.CraneCtld.QueryTasksInfo(ctx, request)
 	if err != nil {
 		logrus.Errorf("RunCommandOnJobNodes failed to query job %d: %v", in.JobId, err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 
 	if !response.GetOk() || len(response.GetTaskInfoList()) == 0 {
@@ -668,16 +691,13 @@ func (s *ServerJob) RunCommandOnJobNodes(ctx context.Context, in *protos.RunComm
 	username := taskInfo.GetUsername()
 	nodeList := strings.Join(in.Nodes, ",")
 
-	// 默认超时时间为30s
-	timeout := 30 * time.Second
-	if in.TimeoutSeconds > 0 {
-		timeout = time.Duration(in.TimeoutSeconds) * time.Second
-	}
+	// 未指定时使用配置的默认超时时间
+	timeout := utils.CommandTimeout(in.TimeoutSeconds)
 
 	logrus.Debugf("RunCommandOnJobNodes calling LocalRunCommandOnNodes with: nodeList=%s, command=%s, username=%s, timeout=%v", nodeList, in.Command, username, timeout)
 
 	// 执行命令
-	stdout, stderr, err := utils.LocalRunCommandOnNodes(nodeList, in.Command, username, timeout)
+	stdout, stderr, err := utils.LocalRunCommandOnNodes(ctx, nodeList, in.Command, username, timeout)
 	if err != nil {
 		logrus.Errorf("RunCommandOnJobNodes failed execution: %v", err)
 		return nil, utils.RichError(codes.Internal, "COMMAND_EXECUTION_FAILED", err.Error())
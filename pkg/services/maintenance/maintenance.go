@@ -0,0 +1,108 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	craneProtos "scow-crane-adapter/gen/crane"
+	"scow-crane-adapter/pkg/utils"
+)
+
+type ServerMaintenance struct {
+	adapterProtos.UnimplementedMaintenanceServiceServer
+}
+
+func (s *ServerMaintenance) ScheduleNodeDrain(ctx context.Context, in *adapterProtos.ScheduleNodeDrainRequest) (*adapterProtos.ScheduleNodeDrainResponse, error) {
+	logrus.Infof("Received request ScheduleNodeDrain: %v", in)
+
+	startTime := time.Now()
+	if in.StartTime != nil {
+		startTime = in.StartTime.AsTime()
+	}
+	drain, err := utils.ScheduleNodeDrain(ctx, in.Nodes, startTime, in.Reason)
+	if err != nil {
+		logrus.Errorf("ScheduleNodeDrain err: %v", err)
+		return nil, nodeDrainError(err)
+	}
+
+	logrus.Infof("ScheduleNodeDrain drain: %v nodes: %v start time: %v success", drain.Id, drain.Nodes, drain.StartTime)
+	return &adapterProtos.ScheduleNodeDrainResponse{DrainId: drain.Id}, nil
+}
+
+func (s *ServerMaintenance) CancelNodeDrain(ctx context.Context, in *adapterProtos.CancelNodeDrainRequest) (*adapterProtos.CancelNodeDrainResponse, error) {
+	logrus.Infof("Received request CancelNodeDrain: %v", in)
+
+	if err := utils.CancelNodeDrain(ctx, in.DrainId); err != nil {
+		logrus.Errorf("CancelNodeDrain err: %v", err)
+		return nil, nodeDrainError(err)
+	}
+
+	logrus.Infof("CancelNodeDrain drain: %v success", in.DrainId)
+	return &adapterProtos.CancelNodeDrainResponse{}, nil
+}
+
+func (s *ServerMaintenance) ListNodeDrains(ctx context.Context, in *adapterProtos.ListNodeDrainsRequest) (*adapterProtos.ListNodeDrainsResponse, error) {
+	logrus.Infof("Received request ListNodeDrains: %v", in)
+
+	drains, err := utils.GetNodeDrains()
+	if err != nil {
+		logrus.Errorf("ListNodeDrains err: %v", err)
+		return nil, utils.RichError(codes.Internal, "NODE_DRAINS_READ_FAILED", err.Error())
+	}
+
+	response := &adapterProtos.ListNodeDrainsResponse{}
+	for _, drain := range drains {
+		nodeDrain := &adapterProtos.NodeDrain{
+			DrainId:   drain.Id,
+			Nodes:     drain.Nodes,
+			StartTime: timestamppb.New(drain.StartTime),
+			Reason:    drain.Reason,
+			Status:    drain.Status,
+			Message:   drain.Message,
+		}
+		if drain.DrainedAt != nil {
+			nodeDrain.DrainedTime = timestamppb.New(*drain.DrainedAt)
+		}
+		response.Drains = append(response.Drains, nodeDrain)
+	}
+	return response, nil
+}
+
+func (s *ServerMaintenance) ListNodeMaintenanceStates(ctx context.Context, in *adapterProtos.ListNodeMaintenanceStatesRequest) (*adapterProtos.ListNodeMaintenanceStatesResponse, error) {
+	logrus.Infof("Received request ListNodeMaintenanceStates: %v", in)
+
+	info, err := utils.CraneCtld.QueryCranedInfo(ctx, &craneProtos.QueryCranedInfoRequest{})
+	if err != nil {
+		logrus.Errorf("ListNodeMaintenanceStates err: %v", err)
+		return nil, utils.CraneCallError(err)
+	}
+	states, err := utils.GetNodeMaintenanceStates(info.GetCranedInfoList())
+	if err != nil {
+		logrus.Errorf("ListNodeMaintenanceStates err: %v", err)
+		return nil, utils.RichError(codes.Internal, "NODE_DRAINS_READ_FAILED", err.Error())
+	}
+
+	logrus.Tracef("ListNodeMaintenanceStates response: %v", states)
+	return &adapterProtos.ListNodeMaintenanceStatesResponse{Nodes: states}, nil
+}
+
+// nodeDrainError 请求不合法时返回InvalidArgument，读写排空计划失败时返回Internal，其他错误视为调用CraneCtld失败
+func nodeDrainError(err error) error {
+	switch {
+	case errors.Is(err, utils.ErrNodeDrainIllegal):
+		return utils.RichError(codes.InvalidArgument, "NODE_DRAIN_ILLEGAL", err.Error())
+	case errors.Is(err, utils.ErrNodeAlreadyInDrain):
+		return utils.RichError(codes.FailedPrecondition, "NODE_ALREADY_IN_DRAIN", err.Error())
+	case errors.Is(err, utils.ErrNodeDrainNotFound):
+		return utils.RichError(codes.NotFound, "NODE_DRAIN_NOT_FOUND", err.Error())
+	case errors.Is(err, utils.ErrNodeDrainStore):
+		return utils.RichError(codes.Internal, "NODE_DRAINS_STORE_FAILED", err.Error())
+	}
+	return utils.CraneCallError(err)
+}
@@ -0,0 +1,85 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	"scow-crane-adapter/pkg/utils"
+)
+
+type ServerReservation struct {
+	adapterProtos.UnimplementedReservationServiceServer
+}
+
+func (s *ServerReservation) CreateReservation(ctx context.Context, in *adapterProtos.CreateReservationRequest) (*adapterProtos.CreateReservationResponse, error) {
+	logrus.Infof("Received request CreateReservation: %v", in)
+
+	if err := utils.CheckReservation(ctx, in); err != nil {
+		logrus.Errorf("CreateReservation failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "RESERVATION_ILLEGAL", err.Error())
+	}
+	// 检查账户名
+	for _, accountName := range in.AllowedAccounts {
+		if err := utils.CheckAccount(accountName); err != nil {
+			logrus.Errorf("CreateReservation failed: %v", err)
+			return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+		}
+	}
+
+	if err := utils.CreateReservation(ctx, in); err != nil {
+		logrus.Errorf("CreateReservation err: %v", err)
+		return nil, utils.CraneCallError(err)
+	}
+
+	logrus.Infof("CreateReservation reservation: %v success", in.ReservationName)
+	return &adapterProtos.CreateReservationResponse{}, nil
+}
+
+func (s *ServerReservation) DeleteReservation(ctx context.Context, in *adapterProtos.DeleteReservationRequest) (*adapterProtos.DeleteReservationResponse, error) {
+	logrus.Infof("Received request DeleteReservation: %v", in)
+
+	if _, err := utils.GetReservation(ctx, in.ReservationName); err != nil {
+		logrus.Errorf("DeleteReservation failed: %v", err)
+		if errors.Is(err, utils.ErrReservationNotFound) {
+			return nil, utils.RichError(codes.NotFound, "RESERVATION_NOT_FOUND", err.Error())
+		}
+		return nil, utils.CraneCallError(err)
+	}
+
+	if err := utils.DeleteReservation(ctx, in.ReservationName); err != nil {
+		logrus.Errorf("DeleteReservation err: %v", err)
+		return nil, utils.CraneCallError(err)
+	}
+
+	logrus.Infof("DeleteReservation reservation: %v success", in.ReservationName)
+	return &adapterProtos.DeleteReservationResponse{}, nil
+}
+
+func (s *ServerReservation) ListReservations(ctx context.Context, in *adapterProtos.ListReservationsRequest) (*adapterProtos.ListReservationsResponse, error) {
+	logrus.Infof("Received request ListReservations: %v", in)
+
+	reservations, err := utils.GetReservations(ctx)
+	if err != nil {
+		logrus.Errorf("ListReservations err: %v", err)
+		return nil, utils.CraneCallError(err)
+	}
+	return &adapterProtos.ListReservationsResponse{Reservations: reservations}, nil
+}
+
+func (s *ServerReservation) GetReservation(ctx context.Context, in *adapterProtos.GetReservationRequest) (*adapterProtos.GetReservationResponse, error) {
+	logrus.Infof("Received request GetReservation: %v", in)
+
+	reservation, err := utils.GetReservation(ctx, in.ReservationName)
+	if err != nil {
+		logrus.Errorf("GetReservation err: %v", err)
+		if errors.Is(err, utils.ErrReservationNotFound) {
+			return nil, utils.RichError(codes.NotFound, "RESERVATION_NOT_FOUND", err.Error())
+		}
+		return nil, utils.CraneCallError(err)
+	}
+	return &adapterProtos.GetReservationResponse{Reservation: reservation}, nil
+}
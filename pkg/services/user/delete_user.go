@@ -0,0 +1,83 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	"scow-crane-adapter/pkg/utils"
+)
+
+func (s *ServerUser) DeleteUserWithOptions(ctx context.Context, in *adapterProtos.DeleteUserWithOptionsRequest) (*adapterProtos.DeleteUserWithOptionsResponse, error) {
+	logrus.Infof("Received request DeleteUserWithOptions: %v", in)
+
+	cancelledJobIds, err := checkUserUnfinishedJobs(ctx, in.UserId, in.Force, in.CancelJobsWaitTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := utils.DeleteUserFromAllAccounts(ctx, in.UserId)
+	if err != nil {
+		logrus.Errorf("DeleteUserWithOptions err: %v", err)
+		return nil, utils.CraneCallError(err)
+	}
+
+	response := &adapterProtos.DeleteUserWithOptionsResponse{CancelledJobIds: cancelledJobIds}
+	for _, result := range results {
+		accountResult := &adapterProtos.DeleteUserWithOptionsResponse_AccountResult{
+			AccountName: result.AccountName,
+			Success:     result.Err == nil,
+		}
+		if result.Err != nil {
+			accountResult.Message = result.Err.Error()
+		}
+		response.AccountResults = append(response.AccountResults, accountResult)
+	}
+
+	logrus.Infof("DeleteUserWithOptions: %v finished, force: %v, results: %v", in.UserId, in.Force, response.AccountResults)
+	return response, nil
+}
+
+// checkUserUnfinishedJobs 检查用户是否有排队或运行中的作业，有作业时不允许删除用户，
+// force为true时先取消这些作业并最多等待waitTimeout秒，返回被取消的作业
+func checkUserUnfinishedJobs(ctx context.Context, userId string, force bool, waitTimeout uint32) ([]uint32, error) {
+	// 检查用户名是否在
+	exist, err := utils.SelectUserExists(ctx, userId)
+	if err != nil {
+		logrus.Errorf("DeleteUser failed: %v", err)
+		return nil, utils.CraneCallError(err)
+	}
+	if !exist {
+		err = fmt.Errorf("user %s not found", userId)
+		logrus.Errorf("DeleteUser failed: %v", err)
+		return nil, utils.RichError(codes.NotFound, "USER_NOT_FOUND", err.Error())
+	}
+
+	taskIds, err := utils.GetUnfinishedTaskIdsByUserName(ctx, userId)
+	if err != nil {
+		logrus.Errorf("DeleteUser failed: get jobs by user %v failed: %v", userId, err)
+		return nil, utils.CraneCallError(err)
+	}
+	logrus.Tracef("DeleteUser unfinished jobs of %v: %v", userId, taskIds)
+
+	remaining := taskIds
+	if force && len(taskIds) != 0 {
+		remaining, err = utils.CancelUserTasks(ctx, userId, taskIds, utils.CancelJobsWaitTimeout(waitTimeout))
+		if err != nil {
+			logrus.Errorf("DeleteUser cancel jobs of %v err: %v", userId, err)
+			return nil, utils.CraneCallError(err)
+		}
+	}
+
+	if len(remaining) != 0 {
+		message := fmt.Sprintf("user %v has unfinished jobs %v", userId, remaining)
+		logrus.Errorf("DeleteUser failed: %v", message)
+		return nil, utils.RichErrorWithMetadata(codes.FailedPrecondition, "EXIST_UNFINISHED_JOBS", message, map[string]string{
+			"job_ids": utils.JoinTaskIds(remaining),
+		})
+	}
+	return utils.SliceSubtract(taskIds, remaining), nil
+}
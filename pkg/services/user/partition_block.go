@@ -0,0 +1,136 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	"scow-crane-adapter/pkg/utils"
+)
+
+func (s *ServerUser) BlockUserInAccountWithPartitions(ctx context.Context, in *adapterProtos.BlockUserInAccountWithPartitionsRequest) (*adapterProtos.BlockUserInAccountWithPartitionsResponse, error) {
+	logrus.Infof("Received request BlockUserInAccountWithPartitions: %v", in)
+
+	if len(in.BlockedPartitions) == 0 {
+		logrus.Infof("BlockUserInAccountWithPartitions: user %v account %v no partition need block", in.UserId, in.AccountName)
+		return &adapterProtos.BlockUserInAccountWithPartitionsResponse{}, nil
+	}
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("BlockUserInAccountWithPartitions failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	if err := utils.BlockUserInAccountWithPartition(ctx, in.UserId, in.AccountName, in.BlockedPartitions); err != nil {
+		logrus.Errorf("BlockUserInAccountWithPartitions err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+
+	logrus.Infof("BlockUserInAccountWithPartitions user: %v account: %v partitions: %v success", in.UserId, in.AccountName, in.BlockedPartitions)
+	return &adapterProtos.BlockUserInAccountWithPartitionsResponse{}, nil
+}
+
+func (s *ServerUser) UnblockUserInAccountWithPartitions(ctx context.Context, in *adapterProtos.UnblockUserInAccountWithPartitionsRequest) (*adapterProtos.UnblockUserInAccountWithPartitionsResponse, error) {
+	logrus.Infof("Received request UnblockUserInAccountWithPartitions: %v", in)
+
+	if len(in.UnblockedPartitions) == 0 {
+		logrus.Infof("UnblockUserInAccountWithPartitions: user %v account %v no partition need unblock", in.UserId, in.AccountName)
+		return &adapterProtos.UnblockUserInAccountWithPartitionsResponse{}, nil
+	}
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("UnblockUserInAccountWithPartitions failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	if err := utils.UnblockUserInAccountWithPartition(ctx, in.UserId, in.AccountName, in.UnblockedPartitions); err != nil {
+		logrus.Errorf("UnblockUserInAccountWithPartitions err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+
+	logrus.Infof("UnblockUserInAccountWithPartitions user: %v account: %v partitions: %v success", in.UserId, in.AccountName, in.UnblockedPartitions)
+	return &adapterProtos.UnblockUserInAccountWithPartitionsResponse{}, nil
+}
+
+func (s *ServerUser) QueryUserInAccountBlockStatusWithPartitions(ctx context.Context, in *adapterProtos.QueryUserInAccountBlockStatusWithPartitionsRequest) (*adapterProtos.QueryUserInAccountBlockStatusWithPartitionsResponse, error) {
+	logrus.Infof("Received request QueryUserInAccountBlockStatusWithPartitions: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("QueryUserInAccountBlockStatusWithPartitions failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	user, err := utils.GetUserInAccount(ctx, in.UserId, in.AccountName)
+	if err != nil {
+		logrus.Errorf("QueryUserInAccountBlockStatusWithPartitions err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+	blockedPartitions, err := utils.GetUserBlockedPartitions(in.UserId, in.AccountName)
+	if err != nil {
+		logrus.Errorf("QueryUserInAccountBlockStatusWithPartitions err: %v", err)
+		return nil, utils.RichError(codes.Internal, "BLOCKED_PARTITIONS_READ_FAILED", err.Error())
+	}
+
+	allPartitions := utils.GetAllPartitions(ctx)
+	partitions := in.Partitions
+	if len(partitions) == 0 {
+		partitions = allPartitions
+	}
+	if unknown := utils.SliceSubtract(partitions, allPartitions); len(unknown) != 0 {
+		logrus.Errorf("QueryUserInAccountBlockStatusWithPartitions failed: partitions %v not found", unknown)
+		return nil, utils.ServiceError(fmt.Errorf("%w: %v", utils.ErrPartitionNotFound, unknown))
+	}
+
+	response := &adapterProtos.QueryUserInAccountBlockStatusWithPartitionsResponse{Blocked: user.GetBlocked()}
+	for _, partition := range partitions {
+		response.UserBlockedDetails = append(response.UserBlockedDetails, &adapterProtos.UserStatusInPartition{
+			Partition: partition,
+			Blocked:   user.GetBlocked() || utils.Contains(blockedPartitions, partition),
+		})
+	}
+
+	logrus.Tracef("QueryUserInAccountBlockStatusWithPartitions response: %v", response)
+	return response, nil
+}
+
+func (s *ServerUser) ListUserBlockedDetails(ctx context.Context, in *adapterProtos.ListUserBlockedDetailsRequest) (*adapterProtos.ListUserBlockedDetailsResponse, error) {
+	logrus.Infof("Received request ListUserBlockedDetails: %v", in)
+
+	accountsWithUsers, err := utils.GetAccountsWithUsers(ctx, in.AccountNames)
+	if err != nil {
+		logrus.Errorf("ListUserBlockedDetails err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+	partitions := utils.GetAllPartitions(ctx)
+
+	response := &adapterProtos.ListUserBlockedDetailsResponse{}
+	for _, accountUsers := range accountsWithUsers {
+		accountName := accountUsers.Account.GetName()
+		accountBlocked := &adapterProtos.ListUserBlockedDetailsResponse_AccountUsers{AccountName: accountName}
+		for _, user := range accountUsers.Users {
+			blockedPartitions, err := utils.GetUserBlockedPartitions(user.GetName(), accountName)
+			if err != nil {
+				logrus.Errorf("ListUserBlockedDetails err: %v", err)
+				return nil, utils.RichError(codes.Internal, "BLOCKED_PARTITIONS_READ_FAILED", err.Error())
+			}
+			userBlocked := &adapterProtos.ListUserBlockedDetailsResponse_UserBlocked{UserId: user.GetName()}
+			for _, partition := range partitions {
+				userBlocked.UserBlockedDetails = append(userBlocked.UserBlockedDetails, &adapterProtos.UserStatusInPartition{
+					Partition: partition,
+					Blocked:   user.GetBlocked() || utils.Contains(blockedPartitions, partition),
+				})
+			}
+			accountBlocked.Users = append(accountBlocked.Users, userBlocked)
+		}
+		response.Accounts = append(response.Accounts, accountBlocked)
+	}
+
+	logrus.Tracef("ListUserBlockedDetails response: %v", response.Accounts)
+	return response, nil
+}
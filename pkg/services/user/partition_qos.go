@@ -0,0 +1,99 @@
+package user
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	"scow-crane-adapter/pkg/utils"
+)
+
+func (s *ServerUser) SetUserPartitionQos(ctx context.Context, in *adapterProtos.SetUserPartitionQosRequest) (*adapterProtos.SetUserPartitionQosResponse, error) {
+	logrus.Infof("Received request SetUserPartitionQos: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("SetUserPartitionQos failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	override := &utils.UserPartitionQos{
+		Partitions: in.GetOverride().GetPartitions(),
+		QosList:    in.GetOverride().GetQosList(),
+		DefaultQos: in.GetOverride().GetDefaultQos(),
+	}
+	if len(override.Partitions) == 0 && len(override.QosList) == 0 && override.DefaultQos == "" {
+		logrus.Errorf("SetUserPartitionQos failed: override is empty")
+		return nil, utils.RichError(codes.InvalidArgument, "OVERRIDE_EMPTY", "The override is empty, use ClearUserPartitionQos instead.")
+	}
+
+	if err := utils.SetUserPartitionQos(ctx, in.UserId, in.AccountName, override); err != nil {
+		logrus.Errorf("SetUserPartitionQos err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+
+	logrus.Infof("SetUserPartitionQos user: %v account: %v success", in.UserId, in.AccountName)
+	return &adapterProtos.SetUserPartitionQosResponse{}, nil
+}
+
+func (s *ServerUser) ClearUserPartitionQos(ctx context.Context, in *adapterProtos.ClearUserPartitionQosRequest) (*adapterProtos.ClearUserPartitionQosResponse, error) {
+	logrus.Infof("Received request ClearUserPartitionQos: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("ClearUserPartitionQos failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	if err := utils.ClearUserPartitionQos(ctx, in.UserId, in.AccountName); err != nil {
+		logrus.Errorf("ClearUserPartitionQos err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+
+	logrus.Infof("ClearUserPartitionQos user: %v account: %v success", in.UserId, in.AccountName)
+	return &adapterProtos.ClearUserPartitionQosResponse{}, nil
+}
+
+func (s *ServerUser) GetUserPartitionQos(ctx context.Context, in *adapterProtos.GetUserPartitionQosRequest) (*adapterProtos.GetUserPartitionQosResponse, error) {
+	logrus.Infof("Received request GetUserPartitionQos: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("GetUserPartitionQos failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	allowedPartitionQos, err := utils.GetUserAllowedPartitionQos(ctx, in.UserId, in.AccountName)
+	if err != nil {
+		logrus.Errorf("GetUserPartitionQos err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+	override, err := utils.GetUserPartitionQos(in.UserId, in.AccountName)
+	if err != nil {
+		logrus.Errorf("GetUserPartitionQos err: %v", err)
+		return nil, utils.RichError(codes.Internal, "OVERRIDE_READ_FAILED", err.Error())
+	}
+
+	response := &adapterProtos.GetUserPartitionQosResponse{}
+	if override != nil {
+		response.Override = &adapterProtos.UserPartitionQosOverride{
+			Partitions: override.Partitions,
+			QosList:    override.QosList,
+		}
+		if override.DefaultQos != "" {
+			response.Override.DefaultQos = &override.DefaultQos
+		}
+	}
+	for _, partitionQos := range allowedPartitionQos {
+		response.AllowedPartitionQosList = append(response.AllowedPartitionQosList, &adapterProtos.GetUserPartitionQosResponse_PartitionQos{
+			Partition:  partitionQos.GetPartitionName(),
+			QosList:    partitionQos.GetQosList(),
+			DefaultQos: partitionQos.GetDefaultQos(),
+		})
+	}
+
+	logrus.Tracef("GetUserPartitionQos response: %v", response)
+	return response, nil
+}
@@ -0,0 +1,115 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	craneProtos "scow-crane-adapter/gen/crane"
+	"scow-crane-adapter/pkg/utils"
+)
+
+var adminLevelMap = map[adapterProtos.AdminLevel]craneProtos.UserInfo_AdminLevel{
+	adapterProtos.AdminLevel_NONE:     craneProtos.UserInfo_None,
+	adapterProtos.AdminLevel_OPERATOR: craneProtos.UserInfo_Operator,
+	adapterProtos.AdminLevel_ADMIN:    craneProtos.UserInfo_Admin,
+}
+
+func (s *ServerUser) SetUserAdminLevel(ctx context.Context, in *adapterProtos.SetUserAdminLevelRequest) (*adapterProtos.SetUserAdminLevelResponse, error) {
+	logrus.Infof("Received request SetUserAdminLevel: %v", in)
+
+	level, ok := adminLevelMap[in.AdminLevel]
+	if !ok {
+		logrus.Errorf("SetUserAdminLevel failed: unknown admin level %v", in.AdminLevel)
+		return nil, utils.RichError(codes.InvalidArgument, "ADMIN_LEVEL_ILLEGAL", "unknown admin level "+in.AdminLevel.String())
+	}
+
+	exist, err := utils.SelectUserExists(ctx, in.UserId)
+	if err != nil {
+		logrus.Errorf("SetUserAdminLevel err: %v", err)
+		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
+	}
+	if !exist {
+		logrus.Errorf("SetUserAdminLevel failed: user %v not exists", in.UserId)
+		return nil, utils.RichError(codes.NotFound, "USER_NOT_FOUND", "The user does not exists.")
+	}
+
+	if err = utils.SetUserAdminLevel(ctx, in.UserId, level); err != nil {
+		logrus.Errorf("SetUserAdminLevel err: %v", err)
+		return nil, utils.CraneCallError(err)
+	}
+
+	logrus.Infof("SetUserAdminLevel user: %v level: %v success", in.UserId, in.AdminLevel)
+	return &adapterProtos.SetUserAdminLevelResponse{}, nil
+}
+
+func (s *ServerUser) AddUserToAccountAsCoordinator(ctx context.Context, in *adapterProtos.AddUserToAccountAsCoordinatorRequest) (*adapterProtos.AddUserToAccountAsCoordinatorResponse, error) {
+	logrus.Infof("Received request AddUserToAccountAsCoordinator: %v", in)
+
+	// 检查账户名
+	if err := utils.CheckAccount(in.AccountName); err != nil {
+		logrus.Errorf("AddUserToAccountAsCoordinator failed: %v", err)
+		return nil, utils.RichError(codes.InvalidArgument, "ACCOUNT_ILLEGAL", err.Error())
+	}
+
+	if err := utils.AddUserToAccountAsCoordinator(ctx, in.AccountName, in.UserId); err != nil {
+		logrus.Errorf("AddUserToAccountAsCoordinator err: %v", err)
+		if errors.Is(err, utils.ErrUserNotFound) {
+			return nil, utils.RichError(codes.NotFound, "USER_NOT_FOUND", "The user is not exists.")
+		}
+		return nil, utils.ServiceError(err)
+	}
+
+	logrus.Infof("AddUserToAccountAsCoordinator user: %v account: %v success", in.UserId, in.AccountName)
+	return &adapterProtos.AddUserToAccountAsCoordinatorResponse{}, nil
+}
+
+func (s *ServerUser) ListAccountCoordinators(ctx context.Context, in *adapterProtos.ListAccountCoordinatorsRequest) (*adapterProtos.ListAccountCoordinatorsResponse, error) {
+	logrus.Infof("Received request ListAccountCoordinators: %v", in)
+
+	coordinators, err := utils.GetAccountCoordinators(ctx, in.AccountNames)
+	if err != nil {
+		logrus.Errorf("ListAccountCoordinators err: %v", err)
+		return nil, utils.RichError(codes.Unavailable, "CRANE_INTERNAL_ERROR", err.Error())
+	}
+
+	var accounts []*adapterProtos.ListAccountCoordinatorsResponse_AccountCoordinators
+	for accountName, users := range coordinators {
+		accounts = append(accounts, &adapterProtos.ListAccountCoordinatorsResponse_AccountCoordinators{
+			AccountName:  accountName,
+			Coordinators: users,
+		})
+	}
+
+	logrus.Tracef("ListAccountCoordinators response: %v", accounts)
+	return &adapterProtos.ListAccountCoordinatorsResponse{Accounts: accounts}, nil
+}
+
+func (s *ServerUser) ListAccountUserRoles(ctx context.Context, in *adapterProtos.ListAccountUserRolesRequest) (*adapterProtos.ListAccountUserRolesResponse, error) {
+	logrus.Infof("Received request ListAccountUserRoles: %v", in)
+
+	accountsWithUsers, err := utils.GetAccountsWithUsers(ctx, in.AccountNames)
+	if err != nil {
+		logrus.Errorf("ListAccountUserRoles err: %v", err)
+		return nil, utils.ServiceError(err)
+	}
+
+	response := &adapterProtos.ListAccountUserRolesResponse{}
+	for _, accountUsers := range accountsWithUsers {
+		accountRoles := &adapterProtos.ListAccountUserRolesResponse_AccountRoles{AccountName: accountUsers.Account.GetName()}
+		for _, user := range accountUsers.Users {
+			accountRoles.Users = append(accountRoles.Users, &adapterProtos.ListAccountUserRolesResponse_UserRole{
+				UserId:      user.GetName(),
+				AdminLevel:  adapterProtos.AdminLevel(user.GetAdminLevel()),
+				Coordinator: utils.Contains(accountUsers.Account.GetCoordinators(), user.GetName()),
+			})
+		}
+		response.Accounts = append(response.Accounts, accountRoles)
+	}
+
+	logrus.Tracef("ListAccountUserRoles response: %v", response.Accounts)
+	return response, nil
+}
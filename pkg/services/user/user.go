@@ -2,8 +2,8 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -17,53 +17,15 @@ type ServerUser struct {
 }
 
 func (s *ServerUser) AddUserToAccount(ctx context.Context, in *protos.AddUserToAccountRequest) (*protos.AddUserToAccountResponse, error) {
-	var allowedPartitionQosList []*craneProtos.UserInfo_AllowedPartitionQos
 	logrus.Infof("Received request AddUserToAccount: %v", in)
 
-	account, err := utils.GetAccountByName(in.AccountName)
-	if err != nil {
-		logrus.Errorf("AddUserToAccount get account failed: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
-	}
-
-	// 获取计算分区 配置qos
-	for _, partition := range account.AllowedPartitions {
-		allowedPartitionQosList = append(allowedPartitionQosList, &craneProtos.UserInfo_AllowedPartitionQos{
-			PartitionName: partition,
-			QosList:       account.AllowedQosList,
-			DefaultQos:    account.DefaultQos,
-		})
-	}
-	uid, err := utils.GetUidByUserName(in.UserId)
-	if err != nil {
-		logrus.Errorf("AddUserToAccount err: %v", err)
-		return nil, utils.RichError(codes.NotFound, "USER_NOT_FOUND", "The user is not exists.")
-	}
-	user := &craneProtos.UserInfo{
-		Uid:                     uint32(uid),
-		Name:                    in.UserId,
-		Account:                 in.AccountName,
-		Blocked:                 false,
-		AllowedPartitionQosList: allowedPartitionQosList,
-		AdminLevel:              craneProtos.UserInfo_None, // none
-	}
-	// 添加用户到账户下的请求体
-	request := &craneProtos.AddUserRequest{
-		Uid:  0,
-		User: user,
-	}
-	response, err := utils.CraneCtld.AddUser(context.Background(), request)
-	if err != nil {
+	// 用户已在账户中时视为成功，并补齐账户的分区和qos
+	if err := utils.AddUserToAccount(ctx, in.AccountName, in.UserId); err != nil {
 		logrus.Errorf("AddUserToAccount err: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
-	}
-	if !response.GetOk() {
-		if response.GetCode() == craneProtos.ErrCode_ERR_USER_ALREADY_EXISTS {
-			logrus.Errorf("AddUserToAccount err: %v", response.GetCode())
-			return nil, utils.RichError(codes.AlreadyExists, "USER_ALREADY_EXISTS", strconv.FormatInt(int64(response.GetCode()), 10))
+		if errors.Is(err, utils.ErrUserNotFound) {
+			return nil, utils.RichError(codes.NotFound, "USER_NOT_FOUND", "The user is not exists.")
 		}
-		logrus.Errorf("AddUserToAccount err: %v", response.GetCode())
-		return nil, utils.RichError(codes.Internal, "FAILED_ADD_USER_TO_ACCOUNT", strconv.FormatInt(int64(response.GetCode()), 10))
+		return nil, utils.ServiceError(err)
 	}
 	logrus.Infof("AddUserToAccount success! user: %v, account: %v", in.UserId, in.AccountName)
 	return &protos.AddUserToAccountResponse{}, nil
@@ -77,15 +39,16 @@ func (s *ServerUser) RemoveUserFromAccount(ctx context.Context, in *protos.Remov
 		UserList: []string{in.UserId},
 	}
 
-	response, err := utils.CraneCtld.DeleteUser(context.Background(), request)
+	response, err := utils.CraneCtld.DeleteUser(ctx, request)
 	if err != nil {
 		logrus.Errorf("RemoveUserFromAccount err: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 	if !response.GetOk() {
 		logrus.Errorf("RemoveUserFromAccount err: %v", fmt.Errorf("ASSOCIATION_NOT_EXISTS"))
 		return nil, utils.RichError(codes.NotFound, "ASSOCIATION_NOT_EXISTS", response.GetRichErrorList()[0].GetDescription())
 	}
+	utils.DeleteUserRecords(in.UserId, in.AccountName)
 	logrus.Infof("RemoveUserFromAccount success! user: %v, account: %v", in.UserId, in.AccountName)
 	return &protos.RemoveUserFromAccountResponse{}, nil
 }
@@ -99,10 +62,10 @@ func (s *ServerUser) BlockUserInAccount(ctx context.Context, in *protos.BlockUse
 		EntityList: []string{in.UserId},
 		Account:    in.AccountName,
 	}
-	response, err := utils.CraneCtld.BlockAccountOrUser(context.Background(), request)
+	response, err := utils.CraneCtld.BlockAccountOrUser(ctx, request)
 	if err != nil {
 		logrus.Errorf("BlockUserInAccount err: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 	if !response.GetOk() {
 		logrus.Errorf("BlockUserInAccount err: %v", fmt.Errorf("ASSOCIATION_NOT_EXISTS"))
@@ -121,10 +84,10 @@ func (s *ServerUser) UnblockUserInAccount(ctx context.Context, in *protos.Unbloc
 		EntityList: []string{in.UserId},
 		Account:    in.AccountName,
 	}
-	response, err := utils.CraneCtld.BlockAccountOrUser(context.Background(), request)
+	response, err := utils.CraneCtld.BlockAccountOrUser(ctx, request)
 	if err != nil {
 		logrus.Errorf("UnblockUserInAccount err: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 	if !response.GetOk() {
 		logrus.Errorf("UnblockUserInAccount err: %v", fmt.Errorf("ASSOCIATION_NOT_EXISTS"))
@@ -141,10 +104,10 @@ func (s *ServerUser) QueryUserInAccountBlockStatus(ctx context.Context, in *prot
 		UserList: []string{in.UserId},
 		Account:  in.AccountName,
 	}
-	response, err := utils.CraneCtld.QueryUserInfo(context.Background(), request)
+	response, err := utils.CraneCtld.QueryUserInfo(ctx, request)
 	if err != nil {
 		logrus.Errorf("QueryUserInAccountBlockStatus err: %v", err)
-		return nil, utils.RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+		return nil, utils.CraneCallError(err)
 	}
 	if !response.GetOk() {
 		logrus.Errorf("QueryUserInAccountBlockStatus err: %v", fmt.Errorf("CRANE_INTERNAL_ERROR"))
@@ -157,35 +120,18 @@ func (s *ServerUser) QueryUserInAccountBlockStatus(ctx context.Context, in *prot
 }
 
 func (s *ServerUser) DeleteUser(ctx context.Context, in *protos.DeleteUserRequest) (*protos.DeleteUserResponse, error) {
-	// 检查用户名是否在
-	exist, err := utils.SelectUserExists(in.UserId)
-	if err != nil {
-		logrus.Errorf("DeleteUser failed: %v", err)
-		return nil, utils.RichError(codes.Internal, "SQL_QUERY_FAILED", err.Error())
-	}
-	if !exist {
-		err = fmt.Errorf("user %s not found", in.UserId)
-		logrus.Errorf("DeleteUser failed: %v", err)
-		return nil, utils.RichError(codes.NotFound, "USER_NOT_FOUND", err.Error())
-	}
+	logrus.Infof("Received request DeleteUser: %v", in)
 
-	// 该用户作业的判断
-	hasJobs, err := utils.HasUnfinishedJobsByUserName(in.UserId)
-	if err != nil {
-		logrus.Errorf("DeleteUser failed: get jobs by user %v failed: %v", in.UserId, err)
-		return nil, utils.RichError(codes.Internal, "SQL_QUERY_FAILED", err.Error())
+	// 有排队或运行中的作业时不允许删除
+	if _, err := checkUserUnfinishedJobs(ctx, in.UserId, false, 0); err != nil {
+		return nil, err
 	}
 
-	if !hasJobs {
-		if err = utils.DeleteUser(in.UserId); err != nil {
-			logrus.Errorf("DeleteUser: %v failed: %v", in.UserId, err)
-			return nil, err
-		}
-		logrus.Infof("Delete User: %v sucess!", in.UserId)
-		return &protos.DeleteUserResponse{}, nil
-	} else {
-		err = fmt.Errorf("DeleteUser failed: Exist running jobs")
-		logrus.Errorf("DeleteUser failed: %v", err)
-		return nil, err
+	if err := utils.DeleteUser(ctx, in.UserId); err != nil {
+		logrus.Errorf("DeleteUser: %v failed: %v", in.UserId, err)
+		return nil, utils.CraneCallError(err)
 	}
+	utils.DeleteUserAllRecords(in.UserId)
+	logrus.Infof("Delete User: %v sucess!", in.UserId)
+	return &protos.DeleteUserResponse{}, nil
 }
@@ -0,0 +1,249 @@
+package utils
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// AuthorizationRoleAdmin、AuthorizationRoleReadOnly 内置角色，admin可以调用所有方法，read-only只能调用Get*、Query*、List*方法
+	AuthorizationRoleAdmin    = "admin"
+	AuthorizationRoleReadOnly = "read-only"
+)
+
+var readOnlyMethodPrefixes = []string{"Get", "Query", "List"}
+
+// AuthorizationPolicy 授权策略文件，yaml格式
+// Roles为自定义角色可以调用的方法，方法为完整方法名，支持通配符，如 /scow.scheduler_adapter.JobService/*
+// Clients按证书Subject、SAN(TLS)或Bearer token(未启用TLS)识别客户端，一个客户端可以有多个角色
+type AuthorizationPolicy struct {
+	Roles   map[string][]string   `yaml:"roles"`
+	Clients []AuthorizationClient `yaml:"clients"`
+}
+
+type AuthorizationClient struct {
+	Name     string   `yaml:"name"`
+	Subjects []string `yaml:"subjects"`
+	Sans     []string `yaml:"sans"`
+	Tokens   []string `yaml:"tokens"`
+	Roles    []string `yaml:"roles"`
+}
+
+// authorizationPolicy 当前使用的授权策略，为nil时不检查授权
+var authorizationPolicy atomic.Pointer[AuthorizationPolicy]
+
+// LoadAuthorizationPolicy 读取并检查授权策略文件
+func LoadAuthorizationPolicy(policyFile string) (*AuthorizationPolicy, error) {
+	content, err := os.ReadFile(policyFile)
+	if err != nil {
+		return nil, fmt.Errorf("read authorization policy file %v failed: %v", policyFile, err)
+	}
+	var policy AuthorizationPolicy
+	if err = yaml.UnmarshalStrict(content, &policy); err != nil {
+		return nil, fmt.Errorf("parse authorization policy file %v failed: %v", policyFile, err)
+	}
+
+	for role, methods := range policy.Roles {
+		if role == AuthorizationRoleAdmin || role == AuthorizationRoleReadOnly {
+			return nil, fmt.Errorf("role %v is a preset and cannot be redefined", role)
+		}
+		for _, method := range methods {
+			if _, err = path.Match(method, ""); err != nil {
+				return nil, fmt.Errorf("invalid method pattern %v of role %v: %v", method, role, err)
+			}
+		}
+	}
+	for _, client := range policy.Clients {
+		if len(client.Subjects) == 0 && len(client.Sans) == 0 && len(client.Tokens) == 0 {
+			return nil, fmt.Errorf("client %v has no subjects, sans or tokens", client.Name)
+		}
+		for _, role := range client.Roles {
+			if _, ok := policy.Roles[role]; !ok && role != AuthorizationRoleAdmin && role != AuthorizationRoleReadOnly {
+				return nil, fmt.Errorf("unknown role %v of client %v", role, client.Name)
+			}
+		}
+	}
+	return &policy, nil
+}
+
+// InitAuthorization 启用时读取授权策略，读取失败时保持当前的策略
+func InitAuthorization(config AuthorizationConfig) error {
+	if !config.Enabled {
+		if authorizationPolicy.Swap(nil) != nil {
+			logrus.Warnf("authorization disabled, all clients can call all methods")
+		}
+		return nil
+	}
+	policy, err := LoadAuthorizationPolicy(config.PolicyFile)
+	if err != nil {
+		return err
+	}
+	logrus.Infof("authorization enabled, %v clients, %v custom roles", len(policy.Clients), len(policy.Roles))
+	authorizationPolicy.Store(policy)
+	return nil
+}
+
+// identify 查找调用方对应的客户端，TLS连接使用已验证的客户端证书，否则使用metadata中的Bearer token
+func (p *AuthorizationPolicy) identify(ctx context.Context) (*AuthorizationClient, string) {
+	if pr, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := pr.AuthInfo.(credentials.TLSInfo); ok {
+			if len(tlsInfo.State.PeerCertificates) == 0 {
+				return nil, "no client certificate"
+			}
+			cert := tlsInfo.State.PeerCertificates[0]
+			for i := range p.Clients {
+				if p.Clients[i].matchCertificate(cert) {
+					return &p.Clients[i], ""
+				}
+			}
+			return nil, fmt.Sprintf("certificate subject %q", cert.Subject.String())
+		}
+	}
+
+	token := bearerToken(ctx)
+	if token == "" {
+		return nil, "no bearer token"
+	}
+	for i := range p.Clients {
+		for _, clientToken := range p.Clients[i].Tokens {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(clientToken)) == 1 {
+				return &p.Clients[i], ""
+			}
+		}
+	}
+	return nil, "unknown bearer token"
+}
+
+func (c *AuthorizationClient) matchCertificate(cert *x509.Certificate) bool {
+	subject := cert.Subject.String()
+	for _, s := range c.Subjects {
+		if s == subject {
+			return true
+		}
+	}
+
+	var sans []string
+	sans = append(sans, cert.DNSNames...)
+	sans = append(sans, cert.EmailAddresses...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	for _, s := range c.Sans {
+		for _, san := range sans {
+			if s == san {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get("authorization") {
+		if token, found := strings.CutPrefix(value, "Bearer "); found {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
+
+// allowed 客户端的任一角色可以调用该方法
+func (p *AuthorizationPolicy) allowed(client *AuthorizationClient, fullMethod string) bool {
+	for _, role := range client.Roles {
+		switch role {
+		case AuthorizationRoleAdmin:
+			return true
+		case AuthorizationRoleReadOnly:
+			if isReadOnlyMethod(fullMethod) {
+				return true
+			}
+		default:
+			for _, pattern := range p.Roles[role] {
+				if pattern == "*" {
+					return true
+				}
+				if matched, _ := path.Match(pattern, fullMethod); matched {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func isReadOnlyMethod(fullMethod string) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize 检查调用方是否可以调用该方法，拒绝时输出审计日志
+// 健康检查不需要授权，使负载均衡和监控无需配置为客户端
+func authorize(ctx context.Context, fullMethod string) error {
+	policy := authorizationPolicy.Load()
+	if policy == nil || strings.HasPrefix(fullMethod, "/"+grpc_health_v1.Health_ServiceDesc.ServiceName+"/") {
+		return nil
+	}
+
+	peerAddr := "unknown"
+	if pr, ok := peer.FromContext(ctx); ok && pr.Addr != nil {
+		peerAddr = pr.Addr.String()
+	}
+	client, reason := policy.identify(ctx)
+	if client == nil {
+		logrus.Warnf("audit: authorization denied, method=%v peer=%v: unidentified client, %v", fullMethod, peerAddr, reason)
+		return RichError(codes.Unauthenticated, "UNAUTHENTICATED", "client is not identified by the authorization policy")
+	}
+	if !policy.allowed(client, fullMethod) {
+		logrus.Warnf("audit: authorization denied, method=%v peer=%v client=%v roles=%v", fullMethod, peerAddr, client.Name, client.Roles)
+		return RichError(codes.PermissionDenied, "PERMISSION_DENIED", fmt.Sprintf("client %v is not allowed to call %v", client.Name, fullMethod))
+	}
+	logrus.Tracef("authorization allowed, method=%v client=%v", fullMethod, client.Name)
+	return nil
+}
+
+// AuthorizationUnaryInterceptor 按授权策略检查一元调用
+func AuthorizationUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthorizationStreamInterceptor 按授权策略检查流式调用
+func AuthorizationStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
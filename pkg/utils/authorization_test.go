@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const testAuthorizationPolicy = `
+roles:
+  job-operator:
+    - /scow.scheduler_adapter.JobService/*
+clients:
+  - name: scow-server
+    subjects: ["CN=scow-server,O=PKU"]
+    roles: [admin]
+  - name: portal
+    sans: ["portal.example.com"]
+    roles: [read-only, job-operator]
+  - name: dashboard
+    tokens: ["dashboard-token"]
+    roles: [read-only]
+`
+
+func writeAuthorizationPolicy(t *testing.T, content string) string {
+	policyFile := filepath.Join(t.TempDir(), "authorization.yaml")
+	require.NoError(t, os.WriteFile(policyFile, []byte(content), 0600))
+	return policyFile
+}
+
+func enableTestAuthorization(t *testing.T) {
+	require.NoError(t, InitAuthorization(AuthorizationConfig{Enabled: true, PolicyFile: writeAuthorizationPolicy(t, testAuthorizationPolicy)}))
+	t.Cleanup(func() { authorizationPolicy.Store(nil) })
+}
+
+// tlsPeerContext 模拟客户端证书已通过验证的TLS连接
+func tlsPeerContext(cert *x509.Certificate) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}},
+	})
+}
+
+func callWithAuthorization(ctx context.Context, method string) error {
+	_, err := AuthorizationUnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	return err
+}
+
+func TestAuthorizationByCertificate(t *testing.T) {
+	enableTestAuthorization(t)
+
+	admin := tlsPeerContext(&x509.Certificate{Subject: pkix.Name{CommonName: "scow-server", Organization: []string{"PKU"}}})
+	assert.NoError(t, callWithAuthorization(admin, "/scow.scheduler_adapter.AccountService/DeleteAccount"))
+
+	portal := tlsPeerContext(&x509.Certificate{Subject: pkix.Name{CommonName: "portal"}, DNSNames: []string{"portal.example.com"}})
+	assert.NoError(t, callWithAuthorization(portal, "/scow.scheduler_adapter.AccountService/ListAccounts"))
+	assert.NoError(t, callWithAuthorization(portal, "/scow.scheduler_adapter.JobService/RunCommandOnJobNodes"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(callWithAuthorization(portal, "/scow.scheduler_adapter.AccountService/DeleteAccount")))
+
+	// CA签名但不在策略中的证书
+	unknown := tlsPeerContext(&x509.Certificate{Subject: pkix.Name{CommonName: "someone"}})
+	assert.Equal(t, codes.Unauthenticated, status.Code(callWithAuthorization(unknown, "/scow.scheduler_adapter.AccountService/ListAccounts")))
+	// 健康检查不需要授权
+	assert.NoError(t, callWithAuthorization(unknown, "/grpc.health.v1.Health/Check"))
+}
+
+func TestAuthorizationByBearerToken(t *testing.T) {
+	enableTestAuthorization(t)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer dashboard-token"))
+	assert.NoError(t, callWithAuthorization(ctx, "/scow.scheduler_adapter.JobService/GetJobs"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(callWithAuthorization(ctx, "/scow.scheduler_adapter.JobService/CancelJob")))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer wrong-token"))
+	assert.Equal(t, codes.Unauthenticated, status.Code(callWithAuthorization(ctx, "/scow.scheduler_adapter.JobService/GetJobs")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(callWithAuthorization(context.Background(), "/scow.scheduler_adapter.JobService/GetJobs")))
+}
+
+func TestAuthorizationDisabled(t *testing.T) {
+	require.NoError(t, InitAuthorization(AuthorizationConfig{}))
+	assert.NoError(t, callWithAuthorization(context.Background(), "/scow.scheduler_adapter.AccountService/DeleteAccount"))
+}
+
+func TestLoadAuthorizationPolicyErrors(t *testing.T) {
+	for name, content := range map[string]string{
+		"unknown role":     "clients:\n  - name: a\n    tokens: [t]\n    roles: [operator]\n",
+		"redefined preset": "roles:\n  admin: [\"*\"]\n",
+		"no identity":      "clients:\n  - name: a\n    roles: [admin]\n",
+		"unknown field":    "client: []\n",
+	} {
+		_, err := LoadAuthorizationPolicy(writeAuthorizationPolicy(t, content))
+		assert.Error(t, err, name)
+	}
+}
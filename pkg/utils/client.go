@@ -4,75 +4,57 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	craneProtos "scow-crane-adapter/gen/crane"
 )
 
 var (
-	CraneCtld     craneProtos.CraneCtldClient
-	CConfig       *CraneConfig
-	MongoDBClient *mongo.Client
-	MongoDBConfig *DatabaseConfig
+	CraneCtld craneProtos.CraneCtldClient
+	craneConn *craneFailoverConn
+	CConfig   *CraneConfig
 )
 
-// InitClientAndConfig 为初始化CraneCtld客户端及鹤思配置文件、MongoDB客户端及配置文件
-func InitClientAndConfig() {
+// InitClientAndConfig 为初始化CraneCtld客户端及鹤思配置文件、MongoDB配置文件
+// tlsConfig中的设置覆盖鹤思配置文件中连接CraneCtld的TLS设置，clientConfig为CraneCtld的地址以及调用的超时和重试策略
+func InitClientAndConfig(tlsConfig CraneTlsConfig, clientConfig CraneClientConfig) {
 	CConfig = ParseConfig(DefaultConfigPath)
-	serverAddr := fmt.Sprintf("%s:%s", CConfig.ControlMachine, CConfig.CraneCtldListenPort)
-	conn, err := grpc.Dial(serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	addrs := clientConfig.Endpoints
+	if len(addrs) == 0 {
+		addrs = []string{fmt.Sprintf("%s:%s", CConfig.ControlMachine, CConfig.CraneCtldListenPort)}
+	}
+	tlsSettings := resolveCraneTlsSettings(CConfig, tlsConfig)
+	endpoints, err := dialCraneEndpoints(addrs, func(addr string) ([]grpc.DialOption, error) {
+		creds, err := craneTransportCredentials(endpointTlsSettings(tlsSettings, CConfig, tlsConfig, addr))
+		if err != nil {
+			return nil, err
+		}
+		return append(craneDialOptions(clientConfig), grpc.WithTransportCredentials(creds)), nil
+	})
 	if err != nil {
 		log.Fatal("Cannot connect to CraneCtld: " + err.Error())
 	}
-	CraneCtld = craneProtos.NewCraneCtldClient(conn)
-
-	// 加载配置
-	MongoDBConfig, err = LoadDBConfig(DefaultMongoDBPath)
-	if err != nil {
-		log.Fatalf("Loading configuration failed: %v", err)
+	craneConn = newCraneFailoverConn(endpoints, clientConfig)
+	healthCheckInterval := defaultCraneHealthCheckInterval
+	if clientConfig.HealthCheckInterval > 0 {
+		healthCheckInterval = time.Duration(clientConfig.HealthCheckInterval) * time.Second
 	}
+	craneConn.startHealthCheck(healthCheckInterval)
+	CraneCtld = craneProtos.NewCraneCtldClient(craneConn)
 
-	// 创建 MongoDB 客户端
-	client, err := createMongoClient(MongoDBConfig)
-	if err != nil {
-		log.Fatalf("Failed to create MongoDB client: %v", err)
-	}
-
-	MongoDBClient = client
+	// MongoDB只用于监控指标，使用时再连接
+	InitDatabase(DefaultMongoDBPath)
 }
 
-// 创建 MongoDB 客户端
-func createMongoClient(config *DatabaseConfig) (*mongo.Client, error) {
-	// 构建连接字符串
-	uri := fmt.Sprintf("mongodb://%s:%s@%s:%d",
-		config.DbUser,
-		config.DbPassword,
-		config.DbHost,
-		config.DbPort)
-
-	// 设置客户端选项
-	clientOptions := options.Client().ApplyURI(uri)
-
-	// 如果配置了副本集名称
-	if config.DbReplSetName != "" {
-		clientOptions.SetReplicaSet(config.DbReplSetName)
+// CloseClients 关闭CraneCtld和MongoDB的连接，退出前调用
+func CloseClients(ctx context.Context) {
+	if craneConn != nil {
+		craneConn.close()
 	}
-
-	// 连接到 MongoDB
-	client, err := mongo.Connect(context.TODO(), clientOptions)
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to MongoDB: %v", err)
+	if err := CloseDatabase(ctx); err != nil {
+		logrus.Warnf("disconnect MongoDB failed: %v", err)
 	}
-
-	// 检查连接
-	err = client.Ping(context.TODO(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("MongoDB connection test failed: %v", err)
-	}
-
-	return client, nil
 }
@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// clusterSnapshotTimeout 获取集群信息时查询CraneCtld的总耗时上限，超时的查询按部分结果返回
+const clusterSnapshotTimeout = 5 * time.Second
+
+// lastSnapshotPartitions 上次查询成功的分区信息，分区查询超时或失败时用于返回部分结果
+var lastSnapshotPartitions atomic.Pointer[map[string]*craneProtos.PartitionInfo]
+
+// ClusterSnapshot 并发查询一次得到的分区、节点和未结束作业，供按分区在内存中聚合
+type ClusterSnapshot struct {
+	Partitions map[string]*craneProtos.PartitionInfo
+	Nodes      []*craneProtos.CranedInfo
+	Tasks      []*craneProtos.TaskInfo
+	// 节点或作业的查询超时、失败时为true，对应的统计为0；分区查询超时、失败时为true，分区信息为上次查询的结果
+	Partial bool
+}
+
+// PartitionNodeCount 分区中各状态节点的个数
+type PartitionNodeCount struct {
+	Idle  uint32
+	Alloc uint32
+	Mix   uint32
+	Down  uint32
+}
+
+// GetClusterSnapshot 并发查询所有分区、所有节点以及所有排队和运行中的作业
+// 查询超时或失败时标记为部分结果；分区查询失败且从未查询成功时返回错误
+func GetClusterSnapshot(ctx context.Context) (*ClusterSnapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, clusterSnapshotTimeout)
+	defer cancel()
+
+	var (
+		wg                                sync.WaitGroup
+		partitionReply                    *craneProtos.QueryPartitionInfoReply
+		cranedReply                       *craneProtos.QueryCranedInfoReply
+		tasksReply                        *craneProtos.QueryTasksInfoReply
+		partitionErr, cranedErr, tasksErr error
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		partitionReply, partitionErr = CraneCtld.QueryPartitionInfo(ctx, &craneProtos.QueryPartitionInfoRequest{})
+	}()
+	go func() {
+		defer wg.Done()
+		cranedReply, cranedErr = CraneCtld.QueryCranedInfo(ctx, &craneProtos.QueryCranedInfoRequest{})
+	}()
+	go func() {
+		defer wg.Done()
+		tasksReply, tasksErr = CraneCtld.QueryTasksInfo(ctx, &craneProtos.QueryTasksInfoRequest{
+			FilterTaskStates:            []craneProtos.TaskStatus{craneProtos.TaskStatus_Pending, craneProtos.TaskStatus_Running},
+			OptionIncludeCompletedTasks: false,
+			NumLimit:                    99999999,
+		})
+		if tasksErr == nil && !tasksReply.GetOk() {
+			tasksErr = fmt.Errorf("query unfinished tasks failed")
+		}
+	}()
+	wg.Wait()
+
+	snapshot := &ClusterSnapshot{Partitions: make(map[string]*craneProtos.PartitionInfo)}
+	if partitionErr != nil {
+		last := lastSnapshotPartitions.Load()
+		if last == nil {
+			return nil, fmt.Errorf("query partitions failed: %v", partitionErr)
+		}
+		logrus.Warnf("GetClusterSnapshot query partitions failed, using the last partitions: %v", partitionErr)
+		snapshot.Partitions = *last
+		snapshot.Partial = true
+	} else {
+		for _, partition := range partitionReply.GetPartitionInfoList() {
+			snapshot.Partitions[partition.GetName()] = partition
+		}
+		lastSnapshotPartitions.Store(&snapshot.Partitions)
+	}
+	if cranedErr != nil {
+		logrus.Warnf("GetClusterSnapshot query nodes failed, node counts are partial: %v", cranedErr)
+		snapshot.Partial = true
+	} else {
+		snapshot.Nodes = cranedReply.GetCranedInfoList()
+	}
+	if tasksErr != nil {
+		logrus.Warnf("GetClusterSnapshot query tasks failed, job counts are partial: %v", tasksErr)
+		snapshot.Partial = true
+	} else {
+		snapshot.Tasks = tasksReply.GetTaskInfoList()
+	}
+	return snapshot, nil
+}
+
+// PartitionNames 快照中的分区名，与GetAllPartitions的排序和过滤方式相同
+func (s *ClusterSnapshot) PartitionNames() []string {
+	discovered := make([]string, 0, len(s.Partitions))
+	for name := range s.Partitions {
+		discovered = append(discovered, name)
+	}
+
+	partitionDiscovery.mu.Lock()
+	allowList := partitionDiscovery.allowList
+	partitionDiscovery.mu.Unlock()
+	return orderPartitions(discovered, configPartitionNames(), allowList)
+}
+
+// NodeCountByPartition 按分区统计各状态节点的个数，节点属于多个分区时在每个分区中都计数
+func (s *ClusterSnapshot) NodeCountByPartition() map[string]*PartitionNodeCount {
+	counts := make(map[string]*PartitionNodeCount)
+	for _, node := range s.Nodes {
+		for _, partitionName := range node.GetPartitionNames() {
+			count, ok := counts[partitionName]
+			if !ok {
+				count = &PartitionNodeCount{}
+				counts[partitionName] = count
+			}
+			switch node.GetResourceState() {
+			case craneProtos.CranedResourceState_CRANE_IDLE:
+				count.Idle++
+			case craneProtos.CranedResourceState_CRANE_ALLOC:
+				count.Alloc++
+			case craneProtos.CranedResourceState_CRANE_MIX:
+				count.Mix++
+			case craneProtos.CranedResourceState_CRANE_DOWN:
+				count.Down++
+			}
+		}
+	}
+	return counts
+}
+
+// JobCountByPartition 按分区统计排队和运行中作业的个数
+func (s *ClusterSnapshot) JobCountByPartition() map[string]*jobCount {
+	counts := make(map[string]*jobCount)
+	for _, task := range s.Tasks {
+		count, ok := counts[task.GetPartition()]
+		if !ok {
+			count = &jobCount{}
+			counts[task.GetPartition()] = count
+		}
+		switch task.GetStatus() {
+		case craneProtos.TaskStatus_Running:
+			count.RunningJobCount++
+		case craneProtos.TaskStatus_Pending:
+			count.PendingJobCount++
+		default:
+			continue
+		}
+		count.JobCount++
+	}
+	return counts
+}
@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+func TestNodeCountByPartition(t *testing.T) {
+	node := func(state craneProtos.CranedResourceState, partitions ...string) *craneProtos.CranedInfo {
+		return &craneProtos.CranedInfo{ResourceState: state, PartitionNames: partitions}
+	}
+	snapshot := &ClusterSnapshot{Nodes: []*craneProtos.CranedInfo{
+		node(craneProtos.CranedResourceState_CRANE_IDLE, "cpu"),
+		node(craneProtos.CranedResourceState_CRANE_ALLOC, "cpu", "gpu"),
+		node(craneProtos.CranedResourceState_CRANE_MIX, "gpu"),
+		node(craneProtos.CranedResourceState_CRANE_DOWN, "gpu"),
+		node(craneProtos.CranedResourceState_CRANE_DOWN),
+	}}
+
+	assert.Equal(t, map[string]*PartitionNodeCount{
+		"cpu": {Idle: 1, Alloc: 1},
+		"gpu": {Alloc: 1, Mix: 1, Down: 1},
+	}, snapshot.NodeCountByPartition())
+}
+
+func TestJobCountByPartition(t *testing.T) {
+	task := func(partition string, status craneProtos.TaskStatus) *craneProtos.TaskInfo {
+		return &craneProtos.TaskInfo{Partition: partition, Status: status}
+	}
+	snapshot := &ClusterSnapshot{Tasks: []*craneProtos.TaskInfo{
+		task("cpu", craneProtos.TaskStatus_Running),
+		task("cpu", craneProtos.TaskStatus_Pending),
+		task("cpu", craneProtos.TaskStatus_Pending),
+		task("gpu", craneProtos.TaskStatus_Running),
+		task("gpu", craneProtos.TaskStatus_Completed),
+	}}
+
+	assert.Equal(t, map[string]*jobCount{
+		"cpu": {JobCount: 3, RunningJobCount: 1, PendingJobCount: 2},
+		"gpu": {JobCount: 1, RunningJobCount: 1},
+	}, snapshot.JobCountByPartition())
+}
+
+func TestClusterSnapshotPartitionNames(t *testing.T) {
+	stub := newFakeCraneCtld()
+	useFakeCraneCtld(t, stub)
+	useCraneConfigPartitions(t, "gpu", "cpu")
+	snapshot := &ClusterSnapshot{Partitions: map[string]*craneProtos.PartitionInfo{"cpu": {}, "gpu": {}, "debug": {}, "a": {}}}
+
+	assert.Equal(t, []string{"gpu", "cpu", "a", "debug"}, snapshot.PartitionNames())
+	InitPartitionDiscovery(PartitionConfig{AllowList: true})
+	assert.Equal(t, []string{"gpu", "cpu"}, snapshot.PartitionNames())
+	// 不再单独查询分区
+	assert.Equal(t, 0, stub.callCount("QueryPartitionInfo"))
+}
+
+func TestGetClusterSnapshotPartial(t *testing.T) {
+	stub := newPartitionStub("cpu")
+	stub.nodes = []*craneProtos.CranedInfo{cranedInfo("cn01", 32, 1024, "cpu")}
+	stub.tasks = []*craneProtos.TaskInfo{{Partition: "cpu", Status: craneProtos.TaskStatus_Running}}
+	useFakeCraneCtld(t, stub)
+	lastSnapshotPartitions.Store(nil)
+	t.Cleanup(func() { lastSnapshotPartitions.Store(nil) })
+	ctx := context.Background()
+
+	// 分区从未查询成功时返回错误
+	stub.mu.Lock()
+	stub.partitionErr = errors.New("unavailable")
+	stub.mu.Unlock()
+	_, err := GetClusterSnapshot(ctx)
+	assert.Error(t, err)
+
+	stub.mu.Lock()
+	stub.partitionErr = nil
+	stub.mu.Unlock()
+	snapshot, err := GetClusterSnapshot(ctx)
+	require.NoError(t, err)
+	assert.False(t, snapshot.Partial)
+	assert.Contains(t, snapshot.Partitions, "cpu")
+	assert.Len(t, snapshot.Nodes, 1)
+	assert.Len(t, snapshot.Tasks, 1)
+
+	// 分区查询失败时使用上次的分区，节点和作业照常返回
+	stub.mu.Lock()
+	stub.partitionErr = errors.New("unavailable")
+	stub.mu.Unlock()
+	snapshot, err = GetClusterSnapshot(ctx)
+	require.NoError(t, err)
+	assert.True(t, snapshot.Partial)
+	assert.Equal(t, []string{"cpu"}, snapshot.PartitionNames())
+	assert.Len(t, snapshot.Nodes, 1)
+	assert.Len(t, snapshot.Tasks, 1)
+}
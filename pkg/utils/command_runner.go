@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+const defaultCommandTimeout = 30 * time.Second
+
+var commandRunnerConfig atomic.Pointer[CommandRunnerConfig]
+
+// SetCommandRunnerConfig 设置在作业节点上执行命令的超时，可以在运行时重新加载
+func SetCommandRunnerConfig(config CommandRunnerConfig) {
+	commandRunnerConfig.Store(&config)
+}
+
+// CommandTimeout 在作业节点上执行命令的超时，请求未指定时使用默认值，配置了最大值时不超过最大值
+func CommandTimeout(requestedSeconds uint32) time.Duration {
+	config := commandRunnerConfig.Load()
+	if config == nil {
+		config = &CommandRunnerConfig{}
+	}
+
+	timeout := defaultCommandTimeout
+	if config.DefaultTimeout > 0 {
+		timeout = time.Duration(config.DefaultTimeout) * time.Second
+	}
+	if requestedSeconds > 0 {
+		timeout = time.Duration(requestedSeconds) * time.Second
+	}
+	if config.MaxTimeout > 0 && timeout > time.Duration(config.MaxTimeout)*time.Second {
+		timeout = time.Duration(config.MaxTimeout) * time.Second
+	}
+	return timeout
+}
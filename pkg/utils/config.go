@@ -7,13 +7,102 @@ type SslConfig struct {
 	AdapterPrivateKeyPath string `yaml:"adapterPrivateKeyPath"`
 }
 
+// MonitorConfig 指标和健康检查HTTP服务的监听地址，BindAddr为空时监听所有地址
 type MonitorConfig struct {
-	Port int `yaml:"port"`
+	BindAddr string `mapstructure:"bind-addr"`
+	Port     int    `yaml:"port"`
+}
+
+// UnixSocketConfig 额外监听的Unix domain socket，供同一台机器上的SCOW连接，Path为空时不监听
+// Mode为socket文件的权限(八进制)，为空时为0660
+type UnixSocketConfig struct {
+	Path string `mapstructure:"path"`
+	Mode string `mapstructure:"mode"`
+}
+
+type LdapConfig struct {
+	Url          string `mapstructure:"url"`
+	BindDn       string `mapstructure:"bind-dn"`
+	BindPassword string `mapstructure:"bind-password"`
+	BaseDn       string `mapstructure:"base-dn"`
+	UserFilter   string `mapstructure:"user-filter"`
+	UidAttribute string `mapstructure:"uid-attribute"`
+	Timeout      int    `mapstructure:"timeout"`
+}
+
+type IdentityConfig struct {
+	Providers  []string   `mapstructure:"providers"`
+	UidMapFile string     `mapstructure:"uid-map-file"`
+	Ldap       LdapConfig `mapstructure:"ldap"`
+}
+
+type PartitionConfig struct {
+	RefreshInterval int  `mapstructure:"refresh-interval"`
+	AllowList       bool `mapstructure:"allow-list"`
+}
+
+// CraneTlsConfig 覆盖鹤思配置文件中连接CraneCtld的TLS设置，为空的项使用鹤思配置文件中的设置
+type CraneTlsConfig struct {
+	Enabled    *bool  `mapstructure:"enabled"`
+	CaCertPath string `mapstructure:"ca-cert-path"`
+	CertPath   string `mapstructure:"cert-path"`
+	KeyPath    string `mapstructure:"key-path"`
+	ServerName string `mapstructure:"server-name"`
+}
+
+// CraneClientConfig 调用CraneCtld的地址、超时、重试和keepalive设置，时间单位为秒，为0时使用默认值
+// Endpoints为空时使用鹤思配置文件中的ControlMachine和CraneCtldListenPort
+type CraneClientConfig struct {
+	Endpoints           []string       `mapstructure:"endpoints"`
+	HealthCheckInterval int            `mapstructure:"health-check-interval"`
+	Timeout             int            `mapstructure:"timeout"`
+	QueryTimeout        int            `mapstructure:"query-timeout"`
+	MethodTimeouts      map[string]int `mapstructure:"method-timeouts"`
+	QueryRetries        int            `mapstructure:"query-retries"`
+	KeepaliveTime       int            `mapstructure:"keepalive-time"`
+	KeepaliveTimeout    int            `mapstructure:"keepalive-timeout"`
+}
+
+// CommandRunnerConfig 在作业节点上执行命令的超时(秒)，DefaultTimeout为请求未指定时的超时，MaxTimeout不为0时限制请求的超时
+type CommandRunnerConfig struct {
+	DefaultTimeout int `mapstructure:"default-timeout"`
+	MaxTimeout     int `mapstructure:"max-timeout"`
+}
+
+// JobCancelConfig 删除账户或用户时取消作业后等待作业结束的时间(秒)，WaitTimeout为请求未指定时的等待时间，MaxWaitTimeout不为0时限制请求的等待时间
+type JobCancelConfig struct {
+	WaitTimeout    int `mapstructure:"wait-timeout"`
+	MaxWaitTimeout int `mapstructure:"max-wait-timeout"`
+}
+
+// HealthConfig 就绪检查设置，CheckInterval为探测CraneCtld的间隔(秒)，CheckMongoDB为true时MongoDB不可用也视为未就绪
+type HealthConfig struct {
+	CheckInterval int  `mapstructure:"check-interval"`
+	CheckMongoDB  bool `mapstructure:"check-mongodb"`
+}
+
+// AuthorizationConfig 按客户端授权调用，Enabled为true时按PolicyFile中的策略检查每个调用
+type AuthorizationConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	PolicyFile string `mapstructure:"policy-file"`
 }
 
 type Config struct {
-	BindPort int           `mapstructure:"bind-port"`
-	LogLevel string        `mapstructure:"log-level"`
-	Ssl      SslConfig     `yaml:"ssl"`
-	Monitor  MonitorConfig `yaml:"monitor"`
+	BindAddr        string              `mapstructure:"bind-addr"`
+	BindPort        int                 `mapstructure:"bind-port"`
+	UnixSocket      UnixSocketConfig    `mapstructure:"unix-socket"`
+	LogLevel        string              `mapstructure:"log-level"`
+	StateDir        string              `mapstructure:"state-dir"`
+	ShutdownTimeout int                 `mapstructure:"shutdown-timeout"`
+	Ssl             SslConfig           `yaml:"ssl"`
+	Monitor         MonitorConfig       `yaml:"monitor"`
+	Identity        IdentityConfig      `mapstructure:"identity"`
+	Partition       PartitionConfig     `mapstructure:"partition"`
+	CraneTls        CraneTlsConfig      `mapstructure:"crane-tls"`
+	CraneClient     CraneClientConfig   `mapstructure:"crane-client"`
+	Health          HealthConfig        `mapstructure:"health"`
+	CommandRunner   CommandRunnerConfig `mapstructure:"command-runner"`
+	JobCancel       JobCancelConfig     `mapstructure:"job-cancel"`
+	Authorization   AuthorizationConfig `mapstructure:"authorization"`
+	NodeFeatures    map[string][]string `mapstructure:"node-features"`
 }
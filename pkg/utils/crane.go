@@ -18,12 +18,12 @@ type jobCount struct {
 	PendingJobCount uint32
 }
 
-func getUsersByAccountName(accountName string) ([]*craneProtos.UserInfo, error) {
+func getUsersByAccountName(ctx context.Context, accountName string) ([]*craneProtos.UserInfo, error) {
 	request := &craneProtos.QueryUserInfoRequest{
 		Uid:     0,
 		Account: accountName,
 	}
-	response, err := CraneCtld.QueryUserInfo(context.Background(), request)
+	response, err := CraneCtld.QueryUserInfo(ctx, request)
 	if err != nil {
 		logrus.Errorf("QueryUserInAccountBlockStatus err: %v", err)
 		return nil, fmt.Errorf("query users failed: %v", err)
@@ -34,12 +34,34 @@ func getUsersByAccountName(accountName string) ([]*craneProtos.UserInfo, error)
 	return response.UserList, nil
 }
 
-func AddUserToAccount(accountName, userName string) error {
+// AddUserToAccount 将用户添加到账户中，用户已在账户中时只补齐账户的分区和qos，重复调用不会报错
+func AddUserToAccount(ctx context.Context, accountName, userName string) error {
+	return addUserToAccount(ctx, accountName, userName, false)
+}
+
+// AddUserToAccountAsCoordinator 将用户作为协调者添加到账户中，鹤思只在添加用户时接受协调者身份，
+// 用户已在账户中但不是协调者时返回ErrCoordinatorChangeUnsupported，其他同AddUserToAccount
+func AddUserToAccountAsCoordinator(ctx context.Context, accountName, userName string) error {
+	return addUserToAccount(ctx, accountName, userName, true)
+}
+
+func addUserToAccount(ctx context.Context, accountName, userName string, coordinator bool) error {
 	var allowedPartitionQosList []*craneProtos.UserInfo_AllowedPartitionQos
 
-	account, err := GetAccountByName(accountName)
+	if err := CheckAccountNotArchived(accountName); err != nil {
+		return err
+	}
+	account, err := GetAccountByName(ctx, accountName)
 	if err != nil {
-		return fmt.Errorf("AddUserToAccount get account failed: %v", err)
+		return fmt.Errorf("AddUserToAccount get account failed: %w", err)
+	}
+
+	if Contains(account.GetUsers(), userName) {
+		if coordinator && !Contains(account.GetCoordinators(), userName) {
+			return fmt.Errorf("%w: user %v already in account %v", ErrCoordinatorChangeUnsupported, userName, accountName)
+		}
+		logrus.Infof("AddUserToAccount user %v already in account %v, reconcile partitions and qos", userName, accountName)
+		return reconcileUserPartitionQos(ctx, userName, account)
 	}
 
 	// 获取计算分区 配置qos
@@ -51,9 +73,9 @@ func AddUserToAccount(accountName, userName string) error {
 		})
 	}
 
-	uid, err := GetUidByUserName(userName)
+	uid, err := GetUidByUserName(ctx, userName)
 	if err != nil {
-		return fmt.Errorf("the user is not exists")
+		return err
 	}
 	user := &craneProtos.UserInfo{
 		Uid:                     uint32(uid),
@@ -63,28 +85,85 @@ func AddUserToAccount(accountName, userName string) error {
 		AllowedPartitionQosList: allowedPartitionQosList,
 		AdminLevel:              craneProtos.UserInfo_None,
 	}
+	if coordinator {
+		user.CoordinatorAccounts = []string{accountName}
+	}
 	requestAddUser := &craneProtos.AddUserRequest{
 		Uid:  0,
 		User: user,
 	}
-	responseUser, err := CraneCtld.AddUser(context.Background(), requestAddUser)
+	responseUser, err := CraneCtld.AddUser(ctx, requestAddUser)
 	if err != nil {
 		logrus.Errorf("CreateAccount err: %v", err)
 		return err
 	}
 	if !responseUser.GetOk() {
+		// 并发的重复请求已经添加了该用户
+		if responseUser.GetCode() == craneProtos.ErrCode_ERR_USER_ALREADY_EXISTS {
+			logrus.Infof("AddUserToAccount user %v already in account %v", userName, accountName)
+			if coordinator {
+				existing, err := GetUserInAccount(ctx, userName, accountName)
+				if err != nil {
+					return err
+				}
+				if !Contains(existing.GetCoordinatorAccounts(), accountName) {
+					return fmt.Errorf("%w: user %v already in account %v", ErrCoordinatorChangeUnsupported, userName, accountName)
+				}
+			}
+			return reconcileUserPartitionQos(ctx, userName, account)
+		}
 		return fmt.Errorf("add user failed, code: %v ", strconv.FormatInt(int64(responseUser.GetCode()), 10))
 	}
 	return nil
 }
 
+// reconcileUserPartitionQos 补齐用户缺少的账户分区和qos，不删除用户已有的分区和qos
+func reconcileUserPartitionQos(ctx context.Context, userName string, account *craneProtos.AccountInfo) error {
+	custom, err := reapplyUserPartitionSettings(ctx, userName, account)
+	if err != nil || custom {
+		return err
+	}
+
+	user, err := GetUserInAccount(ctx, userName, account.GetName())
+	if err != nil {
+		return err
+	}
+
+	userPartitionQos := make(map[string]*craneProtos.UserInfo_AllowedPartitionQos)
+	for _, partitionQos := range user.GetAllowedPartitionQosList() {
+		userPartitionQos[partitionQos.GetPartitionName()] = partitionQos
+	}
+
+	for _, partition := range account.GetAllowedPartitions() {
+		partitionQos, ok := userPartitionQos[partition]
+		if !ok {
+			if err = modifyUserField(ctx, userName, account.GetName(), "", craneProtos.ModifyField_Partition, craneProtos.OperationType_Add, []string{partition}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if missingQos := SliceSubtract(account.GetAllowedQosList(), partitionQos.GetQosList()); len(missingQos) != 0 {
+			if err = modifyUserField(ctx, userName, account.GetName(), partition, craneProtos.ModifyField_Qos, craneProtos.OperationType_Add, missingQos); err != nil {
+				return err
+			}
+		}
+		if partitionQos.GetDefaultQos() != account.GetDefaultQos() {
+			if err = modifyUserField(ctx, userName, account.GetName(), partition, craneProtos.ModifyField_DefaultQos, craneProtos.OperationType_Overwrite, []string{account.GetDefaultQos()}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // SelectAccountExists 查询账户的存在情况，并返回错误
-func SelectAccountExists(account string) (bool, error) {
+func SelectAccountExists(ctx context.Context, account string) (bool, error) {
 	request := &craneProtos.QueryAccountInfoRequest{
 		Uid:         0,
 		AccountList: []string{account},
 	}
-	response, err := CraneCtld.QueryAccountInfo(context.Background(), request)
+	response, err := CraneCtld.QueryAccountInfo(ctx, request)
 	if err != nil {
 		return false, fmt.Errorf("qury account %s failed: %v", account, err)
 	}
@@ -95,23 +174,76 @@ func SelectAccountExists(account string) (bool, error) {
 	return true, nil
 }
 
-func CreateAccount(accountName string) error {
-	var partitionList []string
+// CreateAccount 创建账户，parentAccount不为空时创建为其子账户，父账户不存在时先创建父账户
+// 子账户的分区和qos不能超出父账户，因此子账户继承父账户当前可用的分区、授予记录和qos
+func CreateAccount(ctx context.Context, accountName, parentAccount, description string) (err error) {
 	// 获取计算分区信息
-	for _, partition := range CConfig.Partitions {
-		partitionList = append(partitionList, partition.Name)
-	}
+	partitionList := GetAllPartitions(ctx)
 	// 获取系统QOS
-	qosList, err := GetAllQos()
+	qosList, err := GetAllQos(ctx)
 	if err != nil {
 		return err
 	}
+	defaultQos := qosList[0]
+	grant := &PartitionGrant{Granted: partitionList}
+
+	parentBlockedBy := ""
+	if parentAccount != "" {
+		// 父账户的检查、创建以及子账户的创建需要整体串行，否则子账户创建失败时可能删除其他请求刚在其下创建了子账户的父账户
+		parentAccountMu.Lock()
+		defer parentAccountMu.Unlock()
+
+		var parent *craneProtos.AccountInfo
+		var created bool
+		var parentGrant *PartitionGrant
+		parent, created, err = ensureParentAccount(ctx, parentAccount)
+		if err != nil {
+			return err
+		}
+		if created {
+			// 子账户创建失败时删除本次自动创建的父账户，请求被取消时同样需要删除
+			defer func() {
+				if err == nil {
+					return
+				}
+				cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), craneCleanupTimeout)
+				defer cancel()
+				if deleteErr := DeleteAccount(cleanupCtx, parentAccount); deleteErr != nil {
+					logrus.Errorf("CreateAccount delete auto created parent account %v failed: %v", parentAccount, deleteErr)
+				}
+			}()
+		}
+		parentGrant, err = GetPartitionGrant(ctx, parent)
+		if err != nil {
+			return err
+		}
+		// 鹤思要求子账户的分区是父账户当前分区的子集，父账户被封锁的分区在子账户中同样记为封锁
+		partitionList = parent.GetAllowedPartitions()
+		grant = &PartitionGrant{
+			Granted: append([]string(nil), parentGrant.Granted...),
+			Blocked: append([]string(nil), parentGrant.Blocked...),
+		}
+		qosList = parent.GetAllowedQosList()
+		defaultQos = parent.GetDefaultQos()
+		if parent.GetBlocked() {
+			if parentBlockedBy, err = getCascadeBlocker(parentAccount); err != nil {
+				return err
+			}
+			if parentBlockedBy == "" {
+				parentBlockedBy = parentAccount
+			}
+		}
+	}
+	if description == "" {
+		description = defaultAccountDescription
+	}
 
 	AccountInfo := &craneProtos.AccountInfo{
 		Name:              accountName,
-		Description:       "Create account in crane.",
+		Description:       description,
+		ParentAccount:     parentAccount,
 		AllowedPartitions: partitionList,
-		DefaultQos:        qosList[0],
+		DefaultQos:        defaultQos,
 		AllowedQosList:    qosList,
 	}
 	// 创建账户请求体
@@ -119,126 +251,308 @@ func CreateAccount(accountName string) error {
 		Uid:     uint32(os.Getuid()),
 		Account: AccountInfo,
 	}
-	response, err := CraneCtld.AddAccount(context.Background(), request)
+	response, err := CraneCtld.AddAccount(ctx, request)
 	if err != nil {
 		logrus.Errorf("CreateAccount err: %v", err)
 		return err
 	}
 	if !response.GetOk() {
+		if response.GetCode() == craneProtos.ErrCode_ERR_ACCOUNT_ALREADY_EXISTS {
+			return fmt.Errorf("%w: %v", ErrAccountExists, accountName)
+		}
 		return fmt.Errorf("create account error: %v", strconv.FormatInt(int64(response.GetCode()), 10))
 	}
+
+	// 记录授予账户的分区，封锁解封都以此为准
+	if err = savePartitionGrant(accountName, grant); err != nil {
+		return err
+	}
+
+	// 父账户已封锁时子账户同样封锁，记为被父账户的封锁发起者连带封锁
+	if parentBlockedBy != "" {
+		if err = setAccountsBlocked(ctx, []string{accountName}, true); err != nil {
+			return err
+		}
+		return setCascadeBlocker(accountName, parentBlockedBy)
+	}
 	return nil
 }
 
-func BlockAccount(accountName string) error {
-	// 请求体 封锁账户
+// setAccountsBlocked 封锁或解封一组账户
+func setAccountsBlocked(ctx context.Context, accountNames []string, block bool) error {
 	request := &craneProtos.BlockAccountOrUserRequest{
-		Block:      true,
+		Block:      block,
 		EntityType: craneProtos.EntityType_Account,
-		EntityList: []string{accountName},
+		EntityList: accountNames,
 		Uid:        0,
 	}
-	response, err := CraneCtld.BlockAccountOrUser(context.Background(), request)
+	response, err := CraneCtld.BlockAccountOrUser(ctx, request)
 	if err != nil {
-		logrus.Errorf("BlockAccount err: %v", err)
+		logrus.Errorf("BlockAccountOrUser err: %v", err)
 		return err
 	}
 	if !response.GetOk() {
-		logrus.Errorf("BlockAccount err: %v", fmt.Errorf("ACCOUNT_ALREADY_EXISTS"))
+		message := richErrorMessage(response.GetRichErrorList())
+		logrus.Errorf("BlockAccountOrUser %v block %v err: %v", accountNames, block, message)
+		if block {
+			return fmt.Errorf("block account %v failed: %v", accountNames, message)
+		}
+		return fmt.Errorf("unblock account %v failed: %v", accountNames, message)
+	}
+	return nil
+}
+
+// BlockAccount 封锁账户，账户的所有子账户一并封锁
+// 记录被连带封锁的子账户，解封账户时只解封这些子账户，原本就被封锁的子账户保持封锁
+func BlockAccount(ctx context.Context, accountName string) error {
+	descendants, err := GetDescendantAccounts(ctx, accountName)
+	if err != nil {
+		logrus.Errorf("BlockAccount get child accounts of %v err: %v", accountName, err)
+		return err
+	}
+	descendantNames := make([]string, 0, len(descendants))
+	for _, descendant := range descendants {
+		descendantNames = append(descendantNames, descendant.GetName())
+	}
+
+	if err = setAccountsBlocked(ctx, append([]string{accountName}, descendantNames...), true); err != nil {
 		return err
 	}
 
+	// 账户本身被直接封锁，不再随祖先账户解封
+	if err = setCascadeBlocker(accountName, ""); err != nil {
+		return err
+	}
+	for _, descendant := range descendants {
+		blockedBy, err := getCascadeBlocker(descendant.GetName())
+		if err != nil {
+			return err
+		}
+		// 原本未封锁的子账户，以及被本账户的祖先连带封锁的子账户，改为随本账户解封
+		if !descendant.GetBlocked() || (blockedBy != "" && !Contains(descendantNames, blockedBy)) {
+			if err = setCascadeBlocker(descendant.GetName(), accountName); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
-func BlockAccountWithPartition(accountName string, partitions []string) error {
-	// 封锁账户请求体
-	request := &craneProtos.ModifyAccountRequest{
-		ModifyField: craneProtos.ModifyField_Partition,
-		ValueList:   partitions,
-		Name:        accountName,
-		Type:        craneProtos.OperationType_Delete,
-		Uid:         0,
-		Force:       true,
-	}
+// BlockAccountWithPartition 在分区上封锁账户，并在授予记录中标记这些分区被封锁
+func BlockAccountWithPartition(ctx context.Context, accountName string, partitions []string) error {
+	grantMu.Lock()
+	defer grantMu.Unlock()
 
-	response, err := CraneCtld.ModifyAccount(context.Background(), request)
+	account, err := GetAccountByName(ctx, accountName)
 	if err != nil {
+		return err
+	}
+	grant, err := GetPartitionGrant(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	if err = deleteAccountPartitions(ctx, accountName, partitions); err != nil {
 		logrus.Errorf("BlockAccountWithPartitions err: %v", err)
 		return err
 	}
-	if !response.GetOk() {
-		var message string
-		for _, richError := range response.GetRichErrorList() {
-			message += richError.GetDescription() + "\n"
+
+	for _, partition := range partitions {
+		if Contains(grant.Granted, partition) && !Contains(grant.Blocked, partition) {
+			grant.Blocked = append(grant.Blocked, partition)
 		}
-		logrus.Errorf("BlockAccountWithPartitions failed: %v", message)
-		return fmt.Errorf("error: %v", message)
 	}
-	return nil
+	return savePartitionGrant(accountName, grant)
 }
 
-func UnblockAccount(accountName string) error {
-	// 请求体 封锁账户
-	request := &craneProtos.BlockAccountOrUserRequest{
-		Block:      false,
-		EntityType: craneProtos.EntityType_Account,
-		EntityList: []string{accountName},
-		Uid:        0,
+func deleteAccountPartitions(ctx context.Context, accountName string, partitions []string) error {
+	return modifyAccountField(ctx, accountName, craneProtos.ModifyField_Partition, craneProtos.OperationType_Delete, partitions, true)
+}
+
+// UnblockAccount 解封账户以及被该账户连带封锁的子账户，父账户处于封锁状态时不能解封
+func UnblockAccount(ctx context.Context, accountName string) error {
+	if err := CheckAccountNotArchived(accountName); err != nil {
+		return err
 	}
-	response, err := CraneCtld.BlockAccountOrUser(context.Background(), request)
+	blockedAncestor, err := getBlockedAncestor(ctx, accountName)
 	if err != nil {
-		logrus.Errorf("BlockAccount err: %v", err)
 		return err
 	}
-	if !response.GetOk() {
-		logrus.Errorf("BlockAccount err: %v", fmt.Errorf("ACCOUNT_ALREADY_EXISTS"))
+	if blockedAncestor != "" {
+		return fmt.Errorf("%w: %v", ErrParentAccountBlocked, blockedAncestor)
+	}
+
+	descendants, err := GetDescendantAccounts(ctx, accountName)
+	if err != nil {
+		logrus.Errorf("UnblockAccount get child accounts of %v err: %v", accountName, err)
 		return err
 	}
+	accountNames := []string{accountName}
+	for _, descendant := range descendants {
+		blockedBy, err := getCascadeBlocker(descendant.GetName())
+		if err != nil {
+			return err
+		}
+		if blockedBy == accountName {
+			accountNames = append(accountNames, descendant.GetName())
+		}
+	}
 
+	if err = setAccountsBlocked(ctx, accountNames, false); err != nil {
+		return err
+	}
+	for _, name := range accountNames {
+		if err = setCascadeBlocker(name, ""); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func UnblockAccountWithPartition(accountName string, partitions []string) error {
-	// 封锁账户请求体
+// UnblockAccountWithPartition 在分区上解封账户，只恢复授予记录中授予过的分区
+func UnblockAccountWithPartition(ctx context.Context, accountName string, partitions []string) error {
+	grantMu.Lock()
+	defer grantMu.Unlock()
+
+	if err := CheckAccountNotArchived(accountName); err != nil {
+		return err
+	}
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+	grant, err := GetPartitionGrant(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	var grantedPartitions []string
+	for _, partition := range partitions {
+		if !Contains(grant.Granted, partition) {
+			logrus.Warnf("UnblockAccountWithPartitions account %v was never granted partition %v, skip", accountName, partition)
+			continue
+		}
+		grantedPartitions = append(grantedPartitions, partition)
+	}
+	if len(grantedPartitions) == 0 {
+		return nil
+	}
+
+	if err = addAccountPartitions(ctx, accountName, grantedPartitions); err != nil {
+		logrus.Errorf("UnblockAccountWithPartitions err: %v", err)
+		return err
+	}
+
+	grant.Blocked = SliceSubtract(grant.Blocked, grantedPartitions)
+	return savePartitionGrant(accountName, grant)
+}
+
+func addAccountPartitions(ctx context.Context, accountName string, partitions []string) error {
+	if err := modifyAccountField(ctx, accountName, craneProtos.ModifyField_Partition, craneProtos.OperationType_Add, partitions, false); err != nil {
+		return err
+	}
+
+	// 封锁的时候会将账户下面的用户的allow partition删掉，因此解封的时候需要加回来
+	return modifyUserAllowedPartitions(ctx, accountName, partitions)
+}
+
+func modifyAccountField(ctx context.Context, accountName string, field craneProtos.ModifyField, opType craneProtos.OperationType, values []string, force bool) error {
 	request := &craneProtos.ModifyAccountRequest{
-		ModifyField: craneProtos.ModifyField_Partition,
-		ValueList:   partitions,
+		ModifyField: field,
+		ValueList:   values,
 		Name:        accountName,
-		Type:        craneProtos.OperationType_Add,
+		Type:        opType,
 		Uid:         0,
+		Force:       force,
 	}
 
-	response, err := CraneCtld.ModifyAccount(context.Background(), request)
+	response, err := CraneCtld.ModifyAccount(ctx, request)
 	if err != nil {
-		logrus.Errorf("UnblockAccountWithPartitions err: %v", err)
+		logrus.Errorf("modify account %v %v failed: %v", accountName, field, err)
 		return err
 	}
 	if !response.GetOk() {
-		var message string
-		for _, richError := range response.GetRichErrorList() {
-			message += richError.GetDescription() + "\n"
-		}
-		logrus.Errorf("UnblockAccountWithPartitions failed: %v", message)
+		message := richErrorMessage(response.GetRichErrorList())
+		logrus.Errorf("modify account %v %v failed: %v", accountName, field, message)
 		return fmt.Errorf("error: %v", message)
 	}
+	return nil
+}
 
-	// 封锁的时候会将账户下面的用户的allow partition删掉，因此解封的时候需要加回来
-	if err = modifyUserAllowedPartitions(accountName, partitions); err != nil {
-		logrus.Errorf("UnblockAccountWithPartitions err: %v", err)
-		return err
+func modifyUserField(ctx context.Context, userName, accountName, partition string, field craneProtos.ModifyField, opType craneProtos.OperationType, values []string) error {
+	request := &craneProtos.ModifyUserRequest{
+		ModifyField: field,
+		ValueList:   values,
+		Name:        userName,
+		Account:     accountName,
+		Partition:   partition,
+		Type:        opType,
+		Uid:         0,
 	}
 
+	response, err := CraneCtld.ModifyUser(ctx, request)
+	if err != nil {
+		logrus.Errorf("modify user %v in account %v %v failed: %v", userName, accountName, field, err)
+		return err
+	}
+	if !response.GetOk() {
+		message := richErrorMessage(response.GetRichErrorList())
+		logrus.Errorf("modify user %v in account %v %v failed: %v", userName, accountName, field, message)
+		return fmt.Errorf("error: %v", message)
+	}
 	return nil
 }
 
-func modifyUserAllowedPartitions(accountName string, partitions []string) error {
-	users, err := getUsersByAccountName(accountName)
+func richErrorMessage(richErrors []*craneProtos.RichError) string {
+	var message string
+	for _, richError := range richErrors {
+		message += richError.GetDescription() + "\n"
+	}
+	return message
+}
+
+// reasonAt 获取第i个节点或作业操作失败的原因，CraneCtld返回的原因可能少于节点或作业
+func reasonAt(reasons []string, i int) string {
+	if i < len(reasons) {
+		return reasons[i]
+	}
+	return "unknown reason"
+}
+
+// reapplyUserPartitionSettings 按用户单独设置的分区和qos以及分区封锁记录调整用户，用户没有这些设置时返回false
+func reapplyUserPartitionSettings(ctx context.Context, userName string, account *craneProtos.AccountInfo) (bool, error) {
+	userPartitionMu.Lock()
+	defer userPartitionMu.Unlock()
+
+	override, custom, err := getUserPartitionSettings(userName, account.GetName())
+	if err != nil || !custom {
+		return false, err
+	}
+	logrus.Infof("apply account %v user %v partition settings %+v", account.GetName(), userName, override)
+	return true, applyUserPartitionQos(ctx, userName, account, override)
+}
+
+func modifyUserAllowedPartitions(ctx context.Context, accountName string, partitions []string) error {
+	users, err := getUsersByAccountName(ctx, accountName)
 	if err != nil {
 		logrus.Errorf("BlockAccountWithPartitions err: %v", err)
 	}
 
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+
 	for _, user := range users {
+		// 单独设置过分区和qos或在分区上被封锁的用户按其设置恢复，避免被账户的设置覆盖
+		custom, err := reapplyUserPartitionSettings(ctx, user.Name, account)
+		if err != nil {
+			return err
+		}
+		if custom {
+			continue
+		}
+
 		logrus.Infof("modify account %v user %v partitions %v", accountName, user, partitions)
 		request := &craneProtos.ModifyUserRequest{
 			ModifyField: craneProtos.ModifyField_Partition,
@@ -249,7 +563,7 @@ func modifyUserAllowedPartitions(accountName string, partitions []string) error
 			Uid:         0,
 		}
 
-		response, err := CraneCtld.ModifyUser(context.Background(), request)
+		response, err := CraneCtld.ModifyUser(ctx, request)
 		if err != nil {
 			logrus.Errorf("modify user failed: %v", err)
 			return err
@@ -269,13 +583,13 @@ func modifyUserAllowedPartitions(accountName string, partitions []string) error
 }
 
 // SelectUserExists 查询用户的存在情况，并返回错误
-func SelectUserExists(userName string) (bool, error) {
+func SelectUserExists(ctx context.Context, userName string) (bool, error) {
 	request := &craneProtos.QueryUserInfoRequest{
 		Uid:      0,
 		UserList: []string{userName},
 	}
 
-	response, err := CraneCtld.QueryUserInfo(context.Background(), request)
+	response, err := CraneCtld.QueryUserInfo(ctx, request)
 	if err != nil {
 		logrus.Errorf("Failed to show the user %v, error: %v", userName, err)
 		return false, err
@@ -286,14 +600,14 @@ func SelectUserExists(userName string) (bool, error) {
 	return true, nil
 }
 
-func DeleteUserFromAccount(userId, accountName string) error {
+func DeleteUserFromAccount(ctx context.Context, userId, accountName string) error {
 	request := &craneProtos.DeleteUserRequest{
 		Uid:      0,
 		Account:  accountName,
 		UserList: []string{userId},
 	}
 
-	response, err := CraneCtld.DeleteUser(context.Background(), request)
+	response, err := CraneCtld.DeleteUser(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -303,13 +617,13 @@ func DeleteUserFromAccount(userId, accountName string) error {
 	return nil
 }
 
-func DeleteUser(userId string) error {
+func DeleteUser(ctx context.Context, userId string) error {
 	request := &craneProtos.DeleteUserRequest{
 		Uid:      0,
 		UserList: []string{userId},
 	}
 
-	response, err := CraneCtld.DeleteUser(context.Background(), request)
+	response, err := CraneCtld.DeleteUser(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -319,7 +633,7 @@ func DeleteUser(userId string) error {
 	return nil
 }
 
-func BlockUserInAccount(userId, accountName string) error {
+func BlockUserInAccount(ctx context.Context, userId, accountName string) error {
 	request := &craneProtos.BlockAccountOrUserRequest{
 		Block:      true,
 		Uid:        0,
@@ -327,7 +641,7 @@ func BlockUserInAccount(userId, accountName string) error {
 		EntityList: []string{userId},
 		Account:    accountName,
 	}
-	response, err := CraneCtld.BlockAccountOrUser(context.Background(), request)
+	response, err := CraneCtld.BlockAccountOrUser(ctx, request)
 	if err != nil {
 		logrus.Errorf("BlockUserInAccount err: %v", err)
 		return err
@@ -339,7 +653,7 @@ func BlockUserInAccount(userId, accountName string) error {
 	return nil
 }
 
-func UnblockUserInAccount(userId, accountName string) error {
+func UnblockUserInAccount(ctx context.Context, userId, accountName string) error {
 	request := &craneProtos.BlockAccountOrUserRequest{
 		Block:      false,
 		Uid:        0,
@@ -347,7 +661,7 @@ func UnblockUserInAccount(userId, accountName string) error {
 		EntityList: []string{userId},
 		Account:    accountName,
 	}
-	response, err := CraneCtld.BlockAccountOrUser(context.Background(), request)
+	response, err := CraneCtld.BlockAccountOrUser(ctx, request)
 	if err != nil {
 		logrus.Errorf("UnblockUserInAccount err: %v", err)
 		return err
@@ -359,35 +673,22 @@ func UnblockUserInAccount(userId, accountName string) error {
 	return nil
 }
 
-func HasUnfinishedJobsByUserName(userName string) (bool, error) {
-	request := &craneProtos.QueryTasksInfoRequest{
-		FilterUsers:                 []string{userName},
-		OptionIncludeCompletedTasks: false,
-	}
-	response, err := CraneCtld.QueryTasksInfo(context.Background(), request)
-
+func HasUnfinishedJobsByUserName(ctx context.Context, userName string) (bool, error) {
+	taskIds, err := GetUnfinishedTaskIdsByUserName(ctx, userName)
 	if err != nil {
 		return false, err
 	}
-	if !response.GetOk() {
-		return false, nil
-	}
-
-	if len(response.GetTaskInfoList()) != 0 {
-		return true, nil
-	}
-
-	return false, nil
+	return len(taskIds) != 0, nil
 }
 
-func GetAccountAssociatedUser(accountName string, excludeUserList []string) ([]string, error) {
+func GetAccountAssociatedUser(ctx context.Context, accountName string, excludeUserList []string) ([]string, error) {
 	var userList []string
 
 	request := &craneProtos.QueryUserInfoRequest{
 		Uid:     0,
 		Account: accountName,
 	}
-	response, err := CraneCtld.QueryUserInfo(context.Background(), request)
+	response, err := CraneCtld.QueryUserInfo(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -404,12 +705,12 @@ func GetAccountAssociatedUser(accountName string, excludeUserList []string) ([]s
 	return userList, nil
 }
 
-func GetAccountUserBlockedInfo(accountName string) (map[string]bool, error) {
+func GetAccountUserBlockedInfo(ctx context.Context, accountName string) (map[string]bool, error) {
 	request := &craneProtos.QueryUserInfoRequest{
 		Uid:     0,
 		Account: accountName,
 	}
-	response, err := CraneCtld.QueryUserInfo(context.Background(), request)
+	response, err := CraneCtld.QueryUserInfo(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -423,32 +724,19 @@ func GetAccountUserBlockedInfo(accountName string) (map[string]bool, error) {
 	return blockedInfo, nil
 }
 
-func GetJobsStatusDistribution(authorizedPartitions []string) (map[string]*jobCount, error) {
+// GetJobsStatusDistribution 从集群快照中统计有权限的分区中排队和运行中的作业
+func GetJobsStatusDistribution(ctx context.Context, snapshot *ClusterSnapshot, authorizedPartitions []string) map[string]*jobCount {
+	jobCounts := snapshot.JobCountByPartition()
 	partitionJobs := make(map[string]*jobCount)
-	for _, part := range CConfig.Partitions {
-		if !slices.Contains(authorizedPartitions, part.Name) {
+	for _, partitionName := range snapshot.PartitionNames() {
+		if !slices.Contains(authorizedPartitions, partitionName) {
 			continue
 		}
-		// 获取正在运行作业的个数
-		runningJob, err := GetTaskByPartitionAndStatus([]string{part.Name}, []craneProtos.TaskStatus{craneProtos.TaskStatus_Running})
-		if err != nil {
-			return nil, fmt.Errorf("get running task failed: %v", err)
-		}
-		runningJobNum := len(runningJob)
-
-		// 获取正在排队作业的个数
-		pendingJob, err := GetTaskByPartitionAndStatus([]string{part.Name}, []craneProtos.TaskStatus{craneProtos.TaskStatus_Pending})
-		if err != nil {
-			return nil, fmt.Errorf("get pending task failed: %v", err)
-		}
-		pendingJobNum := len(pendingJob)
-
-		totalJobNum := runningJobNum + pendingJobNum
-		partitionJobs[part.Name] = &jobCount{
-			JobCount:        uint32(totalJobNum),
-			RunningJobCount: uint32(runningJobNum),
-			PendingJobCount: uint32(pendingJobNum),
+		if jobs, ok := jobCounts[partitionName]; ok {
+			partitionJobs[partitionName] = jobs
+		} else {
+			partitionJobs[partitionName] = &jobCount{}
 		}
 	}
-	return partitionJobs, nil
+	return partitionJobs
 }
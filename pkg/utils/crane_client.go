@@ -0,0 +1,189 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultCraneCallTimeout      = 30 * time.Second
+	defaultCraneQueryTimeout     = 10 * time.Second
+	defaultCraneQueryRetries     = 3
+	defaultCraneRetryBaseDelay   = 200 * time.Millisecond
+	defaultCraneRetryMaxDelay    = 2 * time.Second
+	defaultCraneKeepaliveTime    = 30 * time.Second
+	defaultCraneKeepaliveTimeout = 10 * time.Second
+)
+
+// craneCallPolicy 调用CraneCtld的超时和重试策略
+type craneCallPolicy struct {
+	callTimeout    time.Duration
+	queryTimeout   time.Duration
+	methodTimeouts map[string]time.Duration
+	queryRetries   int
+	baseDelay      time.Duration
+	maxDelay       time.Duration
+}
+
+func newCraneCallPolicy(config CraneClientConfig) *craneCallPolicy {
+	policy := &craneCallPolicy{
+		callTimeout:    defaultCraneCallTimeout,
+		queryTimeout:   defaultCraneQueryTimeout,
+		methodTimeouts: make(map[string]time.Duration),
+		queryRetries:   defaultCraneQueryRetries,
+		baseDelay:      defaultCraneRetryBaseDelay,
+		maxDelay:       defaultCraneRetryMaxDelay,
+	}
+	if config.Timeout > 0 {
+		policy.callTimeout = time.Duration(config.Timeout) * time.Second
+	}
+	if config.QueryTimeout > 0 {
+		policy.queryTimeout = time.Duration(config.QueryTimeout) * time.Second
+	}
+	// viper读取的配置项名称为小写，按小写匹配方法名
+	for method, timeout := range config.MethodTimeouts {
+		policy.methodTimeouts[strings.ToLower(method)] = time.Duration(timeout) * time.Second
+	}
+	// 配置为负数时不重试
+	if config.QueryRetries != 0 {
+		policy.queryRetries = max(config.QueryRetries, 0)
+	}
+	return policy
+}
+
+// craneDialOptions 连接CraneCtld的keepalive和重连退避，调用策略由craneFailoverConn执行
+func craneDialOptions(config CraneClientConfig) []grpc.DialOption {
+	keepaliveTime := defaultCraneKeepaliveTime
+	if config.KeepaliveTime > 0 {
+		keepaliveTime = time.Duration(config.KeepaliveTime) * time.Second
+	}
+	keepaliveTimeout := defaultCraneKeepaliveTimeout
+	if config.KeepaliveTimeout > 0 {
+		keepaliveTimeout = time.Duration(config.KeepaliveTimeout) * time.Second
+	}
+
+	reconnectBackoff := backoff.DefaultConfig
+	reconnectBackoff.MaxDelay = 30 * time.Second
+	return []grpc.DialOption{
+		// CraneCtld重启或网络中断时尽快发现连接失效，并按退避间隔重连
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                keepaliveTime,
+			Timeout:             keepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: reconnectBackoff}),
+	}
+}
+
+// noRetryCallOption 调用时不重试，用于定期的探测，失败时等下一次探测即可
+type noRetryCallOption struct {
+	grpc.EmptyCallOption
+}
+
+// WithoutRetry 本次调用CraneCtld失败时不重试
+func WithoutRetry() grpc.CallOption {
+	return noRetryCallOption{}
+}
+
+func hasNoRetry(opts []grpc.CallOption) bool {
+	for _, opt := range opts {
+		if _, ok := opt.(noRetryCallOption); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// methodName 从/crane.grpc.CraneCtld/QueryTasksInfo中取出QueryTasksInfo
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// isIdempotent 只有查询可以安全地重试
+func isIdempotent(method string) bool {
+	return strings.HasPrefix(method, "Query")
+}
+
+func (p *craneCallPolicy) timeout(method string) time.Duration {
+	if timeout, ok := p.methodTimeouts[strings.ToLower(method)]; ok {
+		return timeout
+	}
+	if isIdempotent(method) {
+		return p.queryTimeout
+	}
+	return p.callTimeout
+}
+
+// backoffDelay 第attempt次重试前的等待时间，指数增长并加入随机抖动，避免大量请求同时重试
+func (p *craneCallPolicy) backoffDelay(attempt int) time.Duration {
+	delay := p.baseDelay << attempt
+	if delay > p.maxDelay || delay <= 0 {
+		delay = p.maxDelay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// intercept 每次调用使用默认超时，调用方的context中有更早的截止时间时以调用方为准
+// 查询在Unavailable或单次超时时按退避间隔重试，其他调用只调用一次
+func (p *craneCallPolicy) intercept(ctx context.Context, fullMethod string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	method := methodName(fullMethod)
+	retries := 0
+	if isIdempotent(method) && !hasNoRetry(opts) {
+		retries = p.queryRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		callCtx, cancel := context.WithTimeout(ctx, p.timeout(method))
+		err := invoker(callCtx, fullMethod, req, reply, cc, opts...)
+		cancel()
+		if err == nil {
+			return nil
+		}
+
+		code := status.Code(err)
+		// 调用方的context已结束时不再重试
+		if attempt >= retries || ctx.Err() != nil || (code != codes.Unavailable && code != codes.DeadlineExceeded) {
+			return normalizeCraneError(ctx, err)
+		}
+
+		delay := p.backoffDelay(attempt)
+		logrus.Warnf("call CraneCtld %v failed, retry %d/%d after %v: %v", method, attempt+1, retries, delay, err)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return normalizeCraneError(ctx, err)
+		}
+	}
+}
+
+// normalizeCraneError 调用CraneCtld失败时统一返回Unavailable或DeadlineExceeded
+func normalizeCraneError(ctx context.Context, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	switch status.Code(err) {
+	case codes.DeadlineExceeded, codes.Unavailable:
+		return err
+	case codes.Canceled, codes.Unknown, codes.Internal:
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return err
+}
+
+// CraneCallError 将调用CraneCtld的错误转换为返回给scow的错误，超时为DEADLINE_EXCEEDED，其他为UNAVAILABLE
+func CraneCallError(err error) error {
+	if status.Code(err) == codes.DeadlineExceeded {
+		return RichError(codes.DeadlineExceeded, "CRANE_CALL_TIMEOUT", err.Error())
+	}
+	return RichError(codes.Unavailable, "CRANE_CALL_FAILED", err.Error())
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// flakyCraneCtld 前failures次调用返回Unavailable，delay不为0时每次调用先等待delay
+type flakyCraneCtld struct {
+	craneProtos.UnimplementedCraneCtldServer
+	failures int32
+	delay    time.Duration
+	calls    atomic.Int32
+}
+
+func (s *flakyCraneCtld) handle(ctx context.Context) error {
+	call := s.calls.Add(1)
+	if s.delay != 0 {
+		select {
+		case <-time.After(s.delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if call <= s.failures {
+		return status.Error(codes.Unavailable, "craned busy")
+	}
+	return nil
+}
+
+func (s *flakyCraneCtld) QueryPartitionInfo(ctx context.Context, in *craneProtos.QueryPartitionInfoRequest) (*craneProtos.QueryPartitionInfoReply, error) {
+	if err := s.handle(ctx); err != nil {
+		return nil, err
+	}
+	return &craneProtos.QueryPartitionInfoReply{}, nil
+}
+
+func (s *flakyCraneCtld) ModifyNode(ctx context.Context, in *craneProtos.ModifyCranedStateRequest) (*craneProtos.ModifyCranedStateReply, error) {
+	if err := s.handle(ctx); err != nil {
+		return nil, err
+	}
+	return &craneProtos.ModifyCranedStateReply{}, nil
+}
+
+func dialFlakyCraneCtld(t *testing.T, stub *flakyCraneCtld, config CraneClientConfig) craneProtos.CraneCtldClient {
+	s := grpc.NewServer()
+	craneProtos.RegisterCraneCtldServer(s, stub)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go s.Serve(listener)
+	t.Cleanup(s.Stop)
+
+	return craneProtos.NewCraneCtldClient(dialCraneFailoverConn(t, []string{listener.Addr().String()}, config))
+}
+
+// dialCraneFailoverConn 与InitClientAndConfig相同地连接CraneCtld，不启动健康检查
+func dialCraneFailoverConn(t *testing.T, addrs []string, config CraneClientConfig) *craneFailoverConn {
+	endpoints, err := dialCraneEndpoints(addrs, func(addr string) ([]grpc.DialOption, error) {
+		return append(craneDialOptions(config), grpc.WithTransportCredentials(insecure.NewCredentials())), nil
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		for _, endpoint := range endpoints {
+			endpoint.conn.Close()
+		}
+	})
+	return newCraneFailoverConn(endpoints, config)
+}
+
+func TestCraneClientRetriesQueries(t *testing.T) {
+	stub := &flakyCraneCtld{failures: 2}
+	client := dialFlakyCraneCtld(t, stub, CraneClientConfig{QueryRetries: 3})
+
+	_, err := client.QueryPartitionInfo(context.Background(), &craneProtos.QueryPartitionInfoRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), stub.calls.Load())
+}
+
+func TestCraneClientGivesUpAfterRetries(t *testing.T) {
+	stub := &flakyCraneCtld{failures: 10}
+	client := dialFlakyCraneCtld(t, stub, CraneClientConfig{QueryRetries: 2})
+
+	_, err := client.QueryPartitionInfo(context.Background(), &craneProtos.QueryPartitionInfoRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(3), stub.calls.Load())
+}
+
+func TestCraneClientDoesNotRetryModifications(t *testing.T) {
+	stub := &flakyCraneCtld{failures: 1}
+	client := dialFlakyCraneCtld(t, stub, CraneClientConfig{QueryRetries: 3})
+
+	_, err := client.ModifyNode(context.Background(), &craneProtos.ModifyCranedStateRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(1), stub.calls.Load())
+}
+
+func TestCraneClientDefaultDeadline(t *testing.T) {
+	stub := &flakyCraneCtld{delay: 2 * time.Second}
+	client := dialFlakyCraneCtld(t, stub, CraneClientConfig{
+		QueryRetries:   -1,
+		MethodTimeouts: map[string]int{"querypartitioninfo": 1},
+	})
+
+	start := time.Now()
+	_, err := client.QueryPartitionInfo(context.Background(), &craneProtos.QueryPartitionInfoRequest{})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Less(t, time.Since(start), 2*time.Second)
+
+	callErr := CraneCallError(err)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(callErr))
+}
+
+func TestCraneClientHonoursCallerDeadline(t *testing.T) {
+	stub := &flakyCraneCtld{delay: 2 * time.Second}
+	client := dialFlakyCraneCtld(t, stub, CraneClientConfig{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, err := client.QueryPartitionInfo(ctx, &craneProtos.QueryPartitionInfoRequest{})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	// 调用方的截止时间已过，不再重试
+	assert.Equal(t, int32(1), stub.calls.Load())
+}
+
+func TestCraneClientWithoutRetry(t *testing.T) {
+	stub := &flakyCraneCtld{failures: 1}
+	client := dialFlakyCraneCtld(t, stub, CraneClientConfig{QueryRetries: 3})
+
+	_, err := client.QueryPartitionInfo(context.Background(), &craneProtos.QueryPartitionInfoRequest{}, WithoutRetry())
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(1), stub.calls.Load())
+}
@@ -0,0 +1,203 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+const (
+	defaultCraneHealthCheckInterval = 5 * time.Second
+	craneHealthCheckTimeout         = 3 * time.Second
+)
+
+// craneEndpoint 一个CraneCtld地址及其连接
+type craneEndpoint struct {
+	addr    string
+	conn    *grpc.ClientConn
+	healthy bool
+}
+
+// CraneEndpointState CraneCtld地址的健康状态，active表示调用当前发往该地址
+type CraneEndpointState struct {
+	Addr    string
+	Healthy bool
+	Active  bool
+}
+
+// craneFailoverConn 按配置顺序连接多个CraneCtld(主备)，调用发往第一个健康的地址
+// 当前地址返回Unavailable时切换到下一个地址，查询按调用策略重试时即发往新的地址
+type craneFailoverConn struct {
+	mu        sync.Mutex
+	endpoints []*craneEndpoint
+	active    int
+	policy    *craneCallPolicy
+	stop      chan struct{}
+}
+
+func newCraneFailoverConn(endpoints []*craneEndpoint, config CraneClientConfig) *craneFailoverConn {
+	// 启动前不知道哪个地址可用，先认为都健康，由健康检查和调用结果更新
+	for _, endpoint := range endpoints {
+		endpoint.healthy = true
+	}
+	return &craneFailoverConn{
+		endpoints: endpoints,
+		policy:    newCraneCallPolicy(config),
+		stop:      make(chan struct{}),
+	}
+}
+
+// dialCraneEndpoints 连接所有CraneCtld地址，连接在后台建立，地址不可用不影响启动
+func dialCraneEndpoints(addrs []string, dialOptions func(addr string) ([]grpc.DialOption, error)) ([]*craneEndpoint, error) {
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no CraneCtld endpoint configured")
+	}
+	var endpoints []*craneEndpoint
+	for _, addr := range addrs {
+		options, err := dialOptions(addr)
+		if err != nil {
+			return nil, err
+		}
+		conn, err := grpc.Dial(addr, options...)
+		if err != nil {
+			return nil, fmt.Errorf("dial CraneCtld %v failed: %v", addr, err)
+		}
+		endpoints = append(endpoints, &craneEndpoint{addr: addr, conn: conn})
+	}
+	return endpoints, nil
+}
+
+func (c *craneFailoverConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	return c.policy.intercept(ctx, method, args, reply, nil, c.invoke, opts...)
+}
+
+func (c *craneFailoverConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return c.activeEndpoint().conn.NewStream(ctx, desc, method, opts...)
+}
+
+// invoke 调用当前地址，地址不可用时切换到其他地址，由调用策略决定是否重试
+func (c *craneFailoverConn) invoke(ctx context.Context, method string, req, reply interface{}, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+	endpoint := c.activeEndpoint()
+	err := endpoint.conn.Invoke(ctx, method, req, reply, opts...)
+	if status.Code(err) == codes.Unavailable && ctx.Err() == nil {
+		c.failover(endpoint)
+	}
+	return err
+}
+
+func (c *craneFailoverConn) activeEndpoint() *craneEndpoint {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.endpoints[c.active]
+}
+
+// failover 将调用失败的地址标记为不健康，没有其他健康的地址时轮换到下一个地址，使重试可以尝试其他地址
+func (c *craneFailoverConn) failover(endpoint *craneEndpoint) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	endpoint.healthy = false
+	if c.endpoints[c.active] != endpoint {
+		return
+	}
+	next := c.firstHealthy()
+	if next == -1 {
+		next = (c.active + 1) % len(c.endpoints)
+	}
+	c.switchTo(next)
+}
+
+// setHealthy 更新健康检查的结果，切换到配置顺序中第一个健康的地址
+func (c *craneFailoverConn) setHealthy(endpoint *craneEndpoint, healthy bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	endpoint.healthy = healthy
+	if next := c.firstHealthy(); next != -1 {
+		c.switchTo(next)
+	}
+}
+
+func (c *craneFailoverConn) firstHealthy() int {
+	for i, endpoint := range c.endpoints {
+		if endpoint.healthy {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *craneFailoverConn) switchTo(next int) {
+	if next == c.active {
+		return
+	}
+	logrus.Warnf("CraneCtld endpoint switched from %v to %v", c.endpoints[c.active].addr, c.endpoints[next].addr)
+	c.active = next
+}
+
+// checkHealth 逐个查询分区信息探测地址是否可用，备用CraneCtld未接管前查询失败
+func (c *craneFailoverConn) checkHealth() {
+	for _, endpoint := range c.endpoints {
+		ctx, cancel := context.WithTimeout(context.Background(), craneHealthCheckTimeout)
+		_, err := craneProtos.NewCraneCtldClient(endpoint.conn).QueryPartitionInfo(ctx, &craneProtos.QueryPartitionInfoRequest{})
+		cancel()
+		if err != nil {
+			logrus.Debugf("CraneCtld endpoint %v health check failed: %v", endpoint.addr, err)
+		}
+		c.setHealthy(endpoint, err == nil)
+	}
+}
+
+// startHealthCheck 定期检查所有地址的健康状态
+func (c *craneFailoverConn) startHealthCheck(interval time.Duration) {
+	go func() {
+		c.checkHealth()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.checkHealth()
+			case <-c.stop:
+				return
+			}
+		}
+	}()
+}
+
+// close 停止健康检查并关闭所有连接
+func (c *craneFailoverConn) close() {
+	close(c.stop)
+	for _, endpoint := range c.endpoints {
+		endpoint.conn.Close()
+	}
+}
+
+func (c *craneFailoverConn) states() []CraneEndpointState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var states []CraneEndpointState
+	for i, endpoint := range c.endpoints {
+		states = append(states, CraneEndpointState{
+			Addr:    endpoint.addr,
+			Healthy: endpoint.healthy,
+			Active:  i == c.active,
+		})
+	}
+	return states
+}
+
+// GetCraneEndpointStates 获取所有CraneCtld地址的健康状态以及当前使用的地址
+func GetCraneEndpointStates() []CraneEndpointState {
+	if craneConn == nil {
+		return nil
+	}
+	return craneConn.states()
+}
@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// haCraneCtld 主备中的一个CraneCtld替身，standby为true时像未接管的备用CraneCtld一样返回Unavailable
+type haCraneCtld struct {
+	craneProtos.UnimplementedCraneCtldServer
+	standby atomic.Bool
+	calls   atomic.Int32
+}
+
+func (s *haCraneCtld) handle() error {
+	s.calls.Add(1)
+	if s.standby.Load() {
+		return status.Error(codes.Unavailable, "not the active CraneCtld")
+	}
+	return nil
+}
+
+func (s *haCraneCtld) QueryPartitionInfo(ctx context.Context, in *craneProtos.QueryPartitionInfoRequest) (*craneProtos.QueryPartitionInfoReply, error) {
+	if err := s.handle(); err != nil {
+		return nil, err
+	}
+	return &craneProtos.QueryPartitionInfoReply{}, nil
+}
+
+func (s *haCraneCtld) ModifyNode(ctx context.Context, in *craneProtos.ModifyCranedStateRequest) (*craneProtos.ModifyCranedStateReply, error) {
+	if err := s.handle(); err != nil {
+		return nil, err
+	}
+	return &craneProtos.ModifyCranedStateReply{}, nil
+}
+
+func startHaCraneCtld(t *testing.T, stub *haCraneCtld) (string, *grpc.Server) {
+	s := grpc.NewServer()
+	craneProtos.RegisterCraneCtldServer(s, stub)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go s.Serve(listener)
+	t.Cleanup(s.Stop)
+	return listener.Addr().String(), s
+}
+
+func activeAddr(conn *craneFailoverConn) string {
+	for _, state := range conn.states() {
+		if state.Active {
+			return state.Addr
+		}
+	}
+	return ""
+}
+
+func TestCraneFailoverWhenPrimaryStops(t *testing.T) {
+	primary, standby := &haCraneCtld{}, &haCraneCtld{}
+	primaryAddr, primaryServer := startHaCraneCtld(t, primary)
+	standbyAddr, _ := startHaCraneCtld(t, standby)
+	conn := dialCraneFailoverConn(t, []string{primaryAddr, standbyAddr}, CraneClientConfig{})
+	client := craneProtos.NewCraneCtldClient(conn)
+
+	_, err := client.QueryPartitionInfo(context.Background(), &craneProtos.QueryPartitionInfoRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, primaryAddr, activeAddr(conn))
+
+	// 主CraneCtld停止后查询重试时切换到备用CraneCtld，调用方不感知
+	primaryServer.Stop()
+	_, err = client.QueryPartitionInfo(context.Background(), &craneProtos.QueryPartitionInfoRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, standbyAddr, activeAddr(conn))
+	assert.Equal(t, int32(1), standby.calls.Load())
+}
+
+func TestCraneFailoverDoesNotRetryModifications(t *testing.T) {
+	primary, standby := &haCraneCtld{}, &haCraneCtld{}
+	primary.standby.Store(true)
+	primaryAddr, _ := startHaCraneCtld(t, primary)
+	standbyAddr, _ := startHaCraneCtld(t, standby)
+	conn := dialCraneFailoverConn(t, []string{primaryAddr, standbyAddr}, CraneClientConfig{})
+	client := craneProtos.NewCraneCtldClient(conn)
+
+	// 修改类调用不重试，返回错误后切换地址，下一次调用发往备用CraneCtld
+	_, err := client.ModifyNode(context.Background(), &craneProtos.ModifyCranedStateRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(0), standby.calls.Load())
+
+	_, err = client.ModifyNode(context.Background(), &craneProtos.ModifyCranedStateRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), primary.calls.Load())
+	assert.Equal(t, int32(1), standby.calls.Load())
+}
+
+func TestCraneHealthCheckPrefersFirstHealthyEndpoint(t *testing.T) {
+	primary, standby := &haCraneCtld{}, &haCraneCtld{}
+	primary.standby.Store(true)
+	primaryAddr, _ := startHaCraneCtld(t, primary)
+	standbyAddr, _ := startHaCraneCtld(t, standby)
+	conn := dialCraneFailoverConn(t, []string{primaryAddr, standbyAddr}, CraneClientConfig{})
+
+	conn.checkHealth()
+	assert.Equal(t, standbyAddr, activeAddr(conn))
+	assert.Equal(t, []CraneEndpointState{
+		{Addr: primaryAddr, Healthy: false, Active: false},
+		{Addr: standbyAddr, Healthy: true, Active: true},
+	}, conn.states())
+
+	// 主CraneCtld重新接管后切换回配置顺序中的第一个地址
+	primary.standby.Store(false)
+	standby.standby.Store(true)
+	conn.checkHealth()
+	assert.Equal(t, primaryAddr, activeAddr(conn))
+
+	// 所有地址都不可用时保持当前地址
+	primary.standby.Store(true)
+	conn.checkHealth()
+	assert.Equal(t, primaryAddr, activeAddr(conn))
+	for _, state := range conn.states() {
+		assert.False(t, state.Healthy)
+	}
+}
@@ -0,0 +1,272 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// fakeCraneCtld 在内存中保存账户、分区、节点、qos和作业的CraneCtld替身，只实现测试用到的调用
+type fakeCraneCtld struct {
+	craneProtos.UnimplementedCraneCtldServer
+	mu         sync.Mutex
+	accounts   map[string]*craneProtos.AccountInfo
+	partitions []*craneProtos.PartitionInfo
+	nodes      []*craneProtos.CranedInfo
+	qos        []*craneProtos.QosInfo
+	tasks      []*craneProtos.TaskInfo
+	// 不为nil时ModifyNode返回该回复
+	modifyNodeReply *craneProtos.ModifyCranedStateReply
+	// 不为nil时ModifyNode在计数后等待该channel关闭
+	modifyNodeGate chan struct{}
+	// 不为nil时CancelTask返回该回复
+	cancelTaskReply *craneProtos.CancelTaskReply
+	// 最近一次CancelTask的请求
+	lastCancelTask *craneProtos.CancelTaskRequest
+	// 不为nil时QueryPartitionInfo返回该错误
+	partitionErr error
+	// 不为nil时QueryPartitionInfo在计数后等待该channel关闭
+	partitionGate chan struct{}
+	// 各调用的次数，key为方法名
+	calls map[string]int
+}
+
+func newFakeCraneCtld(accounts ...*craneProtos.AccountInfo) *fakeCraneCtld {
+	s := &fakeCraneCtld{accounts: make(map[string]*craneProtos.AccountInfo), calls: make(map[string]int)}
+	for _, account := range accounts {
+		s.accounts[account.GetName()] = account
+	}
+	return s
+}
+
+func (s *fakeCraneCtld) account(name string) *craneProtos.AccountInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return proto.Clone(s.accounts[name]).(*craneProtos.AccountInfo)
+}
+
+func (s *fakeCraneCtld) QueryAccountInfo(ctx context.Context, in *craneProtos.QueryAccountInfoRequest) (*craneProtos.QueryAccountInfoReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := in.GetAccountList()
+	if len(names) == 0 {
+		for name := range s.accounts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	reply := &craneProtos.QueryAccountInfoReply{Ok: true}
+	for _, name := range names {
+		account, ok := s.accounts[name]
+		if !ok {
+			return &craneProtos.QueryAccountInfoReply{RichErrorList: []*craneProtos.RichError{{Description: "account " + name + " not found"}}}, nil
+		}
+		reply.AccountList = append(reply.AccountList, proto.Clone(account).(*craneProtos.AccountInfo))
+	}
+	return reply, nil
+}
+
+func (s *fakeCraneCtld) BlockAccountOrUser(ctx context.Context, in *craneProtos.BlockAccountOrUserRequest) (*craneProtos.BlockAccountOrUserReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, name := range in.GetEntityList() {
+		if account, ok := s.accounts[name]; ok && in.GetEntityType() == craneProtos.EntityType_Account {
+			account.Blocked = in.GetBlock()
+		}
+	}
+	return &craneProtos.BlockAccountOrUserReply{Ok: true}, nil
+}
+
+func (s *fakeCraneCtld) AddAccount(ctx context.Context, in *craneProtos.AddAccountRequest) (*craneProtos.AddAccountReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["AddAccount"]++
+
+	account := in.GetAccount()
+	if _, ok := s.accounts[account.GetName()]; ok {
+		return &craneProtos.AddAccountReply{Code: craneProtos.ErrCode_ERR_ACCOUNT_ALREADY_EXISTS}, nil
+	}
+	if parent := account.GetParentAccount(); parent != "" {
+		parentAccount, ok := s.accounts[parent]
+		if !ok {
+			return &craneProtos.AddAccountReply{Code: craneProtos.ErrCode_ERR_INVALID_PARENT_ACCOUNT}, nil
+		}
+		parentAccount.ChildAccounts = append(parentAccount.ChildAccounts, account.GetName())
+	}
+	s.accounts[account.GetName()] = proto.Clone(account).(*craneProtos.AccountInfo)
+	return &craneProtos.AddAccountReply{Ok: true}, nil
+}
+
+func (s *fakeCraneCtld) callCount(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+func (s *fakeCraneCtld) QueryPartitionInfo(ctx context.Context, in *craneProtos.QueryPartitionInfoRequest) (*craneProtos.QueryPartitionInfoReply, error) {
+	s.mu.Lock()
+	s.calls["QueryPartitionInfo"]++
+	gate := s.partitionGate
+	s.mu.Unlock()
+	if gate != nil {
+		<-gate
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.partitionErr != nil {
+		return nil, s.partitionErr
+	}
+
+	reply := &craneProtos.QueryPartitionInfoReply{}
+	for _, partition := range s.partitions {
+		if in.GetPartitionName() == "" || in.GetPartitionName() == partition.GetName() {
+			reply.PartitionInfoList = append(reply.PartitionInfoList, proto.Clone(partition).(*craneProtos.PartitionInfo))
+		}
+	}
+	return reply, nil
+}
+
+func (s *fakeCraneCtld) QueryCranedInfo(ctx context.Context, in *craneProtos.QueryCranedInfoRequest) (*craneProtos.QueryCranedInfoReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["QueryCranedInfo"]++
+
+	reply := &craneProtos.QueryCranedInfoReply{}
+	for _, node := range s.nodes {
+		reply.CranedInfoList = append(reply.CranedInfoList, proto.Clone(node).(*craneProtos.CranedInfo))
+	}
+	return reply, nil
+}
+
+func (s *fakeCraneCtld) QueryQosInfo(ctx context.Context, in *craneProtos.QueryQosInfoRequest) (*craneProtos.QueryQosInfoReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reply := &craneProtos.QueryQosInfoReply{Ok: true}
+	for _, qos := range s.qos {
+		reply.QosList = append(reply.QosList, proto.Clone(qos).(*craneProtos.QosInfo))
+	}
+	return reply, nil
+}
+
+func resetPartitionDiscovery() {
+	InitPartitionDiscovery(PartitionConfig{})
+	partitionDiscovery.mu.Lock()
+	partitionDiscovery.partitions = nil
+	partitionDiscovery.mu.Unlock()
+}
+
+func (s *fakeCraneCtld) QueryTasksInfo(ctx context.Context, in *craneProtos.QueryTasksInfoRequest) (*craneProtos.QueryTasksInfoReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reply := &craneProtos.QueryTasksInfoReply{Ok: true}
+	for _, task := range s.tasks {
+		reply.TaskInfoList = append(reply.TaskInfoList, proto.Clone(task).(*craneProtos.TaskInfo))
+	}
+	return reply, nil
+}
+
+func (s *fakeCraneCtld) CancelTask(ctx context.Context, in *craneProtos.CancelTaskRequest) (*craneProtos.CancelTaskReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["CancelTask"]++
+	s.lastCancelTask = proto.Clone(in).(*craneProtos.CancelTaskRequest)
+
+	if s.cancelTaskReply != nil {
+		return s.cancelTaskReply, nil
+	}
+	var remaining []*craneProtos.TaskInfo
+	reply := &craneProtos.CancelTaskReply{}
+	for _, task := range s.tasks {
+		if Contains(in.GetFilterTaskIds(), task.GetTaskId()) {
+			reply.CancelledTasks = append(reply.CancelledTasks, task.GetTaskId())
+			continue
+		}
+		remaining = append(remaining, task)
+	}
+	s.tasks = remaining
+	return reply, nil
+}
+
+func (s *fakeCraneCtld) DeleteUser(ctx context.Context, in *craneProtos.DeleteUserRequest) (*craneProtos.DeleteUserReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	account, ok := s.accounts[in.GetAccount()]
+	if !ok {
+		return &craneProtos.DeleteUserReply{RichErrorList: []*craneProtos.RichError{{Description: "account " + in.GetAccount() + " not found"}}}, nil
+	}
+	account.Users = SliceSubtract(account.Users, in.GetUserList())
+	return &craneProtos.DeleteUserReply{Ok: true}, nil
+}
+
+func (s *fakeCraneCtld) ModifyNode(ctx context.Context, in *craneProtos.ModifyCranedStateRequest) (*craneProtos.ModifyCranedStateReply, error) {
+	s.mu.Lock()
+	s.calls["ModifyNode"]++
+	gate := s.modifyNodeGate
+	s.mu.Unlock()
+	if gate != nil {
+		<-gate
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.modifyNodeReply != nil {
+		return s.modifyNodeReply, nil
+	}
+	for _, node := range s.nodes {
+		if Contains(in.GetCranedIds(), node.GetHostname()) {
+			node.ControlState = in.GetNewState()
+		}
+	}
+	return &craneProtos.ModifyCranedStateReply{ModifiedNodes: in.GetCranedIds()}, nil
+}
+
+// useFakeCraneCtld 将CraneCtld替换为连接stub的客户端，并在临时目录中打开状态存储，测试结束后恢复
+func useFakeCraneCtld(t *testing.T, stub craneProtos.CraneCtldServer) {
+	s := grpc.NewServer()
+	craneProtos.RegisterCraneCtldServer(s, stub)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go s.Serve(listener)
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	previous := CraneCtld
+	CraneCtld = craneProtos.NewCraneCtldClient(conn)
+	// 丢弃之前查询到的分区，从stub重新查询
+	resetPartitionDiscovery()
+	t.Cleanup(func() {
+		CraneCtld = previous
+		resetPartitionDiscovery()
+		conn.Close()
+		s.Stop()
+	})
+
+	stores := []**FileStore{&PartitionGrantStore, &AccountLimitStore, &UserLimitStore, &ArchivedAccountStore,
+		&UserOverrideStore, &UserBlockedPartitionStore, &NodeDrainStore, &CascadeBlockStore}
+	previousStores := make([]*FileStore, len(stores))
+	for i, store := range stores {
+		previousStores[i] = *store
+	}
+	require.NoError(t, InitStateStore(filepath.Join(t.TempDir(), "state")))
+	t.Cleanup(func() {
+		for i, store := range stores {
+			*store = previousStores[i]
+		}
+	})
+}
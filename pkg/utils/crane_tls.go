@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// craneTlsSettings 合并鹤思配置文件和适配器配置后，连接CraneCtld使用的TLS设置
+type craneTlsSettings struct {
+	enabled    bool
+	caCertPath string
+	certPath   string
+	keyPath    string
+	serverName string
+}
+
+// resolveCraneTlsSettings 以鹤思配置文件中的TLS设置为准，适配器配置中不为空的项覆盖对应设置
+func resolveCraneTlsSettings(craneConfig *CraneConfig, override CraneTlsConfig) craneTlsSettings {
+	settings := craneTlsSettings{
+		enabled:  craneConfig.UseTls,
+		certPath: craneConfig.ServerCertFilePath,
+		keyPath:  craneConfig.ServerKeyFilePath,
+		// 鹤思内部组件使用同一套证书，未配置CA时信任CraneCtld的服务端证书本身
+		caCertPath: craneConfig.CaCertFilePath,
+		serverName: craneConfig.ControlMachine,
+	}
+	if settings.caCertPath == "" {
+		settings.caCertPath = craneConfig.ServerCertFilePath
+	}
+	// CraneCtld的证书签发给带域名后缀的完整主机名
+	if craneConfig.DomainSuffix != "" {
+		settings.serverName = craneConfig.ControlMachine + "." + craneConfig.DomainSuffix
+	}
+
+	if override.Enabled != nil {
+		settings.enabled = *override.Enabled
+	}
+	if override.CaCertPath != "" {
+		settings.caCertPath = override.CaCertPath
+	}
+	if override.CertPath != "" {
+		settings.certPath = override.CertPath
+	}
+	if override.KeyPath != "" {
+		settings.keyPath = override.KeyPath
+	}
+	if override.ServerName != "" {
+		settings.serverName = override.ServerName
+	}
+	return settings
+}
+
+// endpointTlsSettings 未配置server-name时按各CraneCtld地址的主机名校验证书，主备CraneCtld的证书签发给各自的主机名
+func endpointTlsSettings(settings craneTlsSettings, craneConfig *CraneConfig, override CraneTlsConfig, addr string) craneTlsSettings {
+	if override.ServerName != "" {
+		return settings
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil || net.ParseIP(host) != nil {
+		return settings
+	}
+	settings.serverName = host
+	if craneConfig.DomainSuffix != "" && !strings.Contains(host, ".") {
+		settings.serverName = host + "." + craneConfig.DomainSuffix
+	}
+	return settings
+}
+
+// craneTransportCredentials 生成连接CraneCtld的凭据，未启用TLS时不加密
+// 同时配置了证书和私钥时向CraneCtld出示客户端证书(mTLS)
+func craneTransportCredentials(settings craneTlsSettings) (credentials.TransportCredentials, error) {
+	if !settings.enabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	caCert, err := os.ReadFile(settings.caCertPath)
+	if err != nil {
+		return nil, fmt.Errorf("read crane ca cert failed: %v", err)
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("parse crane ca cert %v failed", settings.caCertPath)
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    certPool,
+		ServerName: settings.serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if settings.certPath != "" && settings.keyPath != "" {
+		pair, err := tls.LoadX509KeyPair(settings.certPath, settings.keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("load crane client cert failed: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{pair}
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
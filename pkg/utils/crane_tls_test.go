@@ -0,0 +1,181 @@
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// stubCraneCtld 本地的CraneCtld替身，只实现查询分区
+type stubCraneCtld struct {
+	craneProtos.UnimplementedCraneCtldServer
+}
+
+func (s *stubCraneCtld) QueryPartitionInfo(ctx context.Context, in *craneProtos.QueryPartitionInfoRequest) (*craneProtos.QueryPartitionInfoReply, error) {
+	return &craneProtos.QueryPartitionInfoReply{
+		PartitionInfoList: []*craneProtos.PartitionInfo{{Name: "CPU"}},
+	}, nil
+}
+
+type testCert struct {
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certPath string
+	keyPath  string
+}
+
+// newTestCert 生成证书并写入dir，parent为nil时生成自签名的CA
+func newTestCert(t *testing.T, dir, name string, parent *testCert, dnsNames []string) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	c := &testCert{
+		cert:     cert,
+		key:      key,
+		certPath: filepath.Join(dir, name+".pem"),
+		keyPath:  filepath.Join(dir, name+".key"),
+	}
+	require.NoError(t, os.WriteFile(c.certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(c.keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return c
+}
+
+// startStubCraneCtld 启动要求客户端证书的CraneCtld替身，返回监听地址
+func startStubCraneCtld(t *testing.T, ca, server *testCert) string {
+	pair, err := tls.LoadX509KeyPair(server.certPath, server.keyPath)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+
+	s := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{pair},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	})))
+	craneProtos.RegisterCraneCtldServer(s, &stubCraneCtld{})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go s.Serve(listener)
+	t.Cleanup(s.Stop)
+	return listener.Addr().String()
+}
+
+func queryStubPartitions(t *testing.T, addr string, settings craneTlsSettings) error {
+	creds, err := craneTransportCredentials(settings)
+	require.NoError(t, err)
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = craneProtos.NewCraneCtldClient(conn).QueryPartitionInfo(ctx, &craneProtos.QueryPartitionInfoRequest{})
+	return err
+}
+
+func TestResolveCraneTlsSettings(t *testing.T) {
+	craneConfig := &CraneConfig{
+		ControlMachine:     "cranectld",
+		DomainSuffix:       "crane.local",
+		UseTls:             true,
+		ServerCertFilePath: "/etc/crane/server.pem",
+		ServerKeyFilePath:  "/etc/crane/server.key",
+	}
+
+	settings := resolveCraneTlsSettings(craneConfig, CraneTlsConfig{})
+	assert.True(t, settings.enabled)
+	assert.Equal(t, "cranectld.crane.local", settings.serverName)
+	assert.Equal(t, "/etc/crane/server.pem", settings.caCertPath)
+
+	disabled := false
+	settings = resolveCraneTlsSettings(craneConfig, CraneTlsConfig{
+		Enabled:    &disabled,
+		CaCertPath: "/etc/adapter/ca.pem",
+		ServerName: "ctld.example.com",
+	})
+	assert.False(t, settings.enabled)
+	assert.Equal(t, "/etc/adapter/ca.pem", settings.caCertPath)
+	assert.Equal(t, "ctld.example.com", settings.serverName)
+	assert.Equal(t, "/etc/crane/server.key", settings.keyPath)
+}
+
+func TestCraneTransportCredentialsMutualTls(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, dir, "ca", nil, nil)
+	server := newTestCert(t, dir, "server", ca, []string{"cranectld.crane.local"})
+	client := newTestCert(t, dir, "client", ca, nil)
+	addr := startStubCraneCtld(t, ca, server)
+
+	settings := resolveCraneTlsSettings(&CraneConfig{
+		ControlMachine:     "cranectld",
+		DomainSuffix:       "crane.local",
+		UseTls:             true,
+		CaCertFilePath:     ca.certPath,
+		ServerCertFilePath: client.certPath,
+		ServerKeyFilePath:  client.keyPath,
+	}, CraneTlsConfig{})
+	assert.NoError(t, queryStubPartitions(t, addr, settings))
+
+	// 主机名与证书不符时拒绝连接
+	wrongName := settings
+	wrongName.serverName = "other.crane.local"
+	assert.Error(t, queryStubPartitions(t, addr, wrongName))
+
+	// 未出示客户端证书时CraneCtld拒绝连接
+	noClientCert := settings
+	noClientCert.certPath, noClientCert.keyPath = "", ""
+	assert.Error(t, queryStubPartitions(t, addr, noClientCert))
+
+	// 不信任CraneCtld证书的签发者时拒绝连接
+	otherCa := newTestCert(t, dir, "other-ca", nil, nil)
+	untrusted := settings
+	untrusted.caCertPath = otherCa.certPath
+	assert.Error(t, queryStubPartitions(t, addr, untrusted))
+}
+
+func TestCraneTransportCredentialsMissingCa(t *testing.T) {
+	_, err := craneTransportCredentials(craneTlsSettings{enabled: true, caCertPath: filepath.Join(t.TempDir(), "missing.pem")})
+	assert.Error(t, err)
+}
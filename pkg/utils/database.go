@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// 连接MongoDB失败后，间隔mongoReconnectInterval再重新连接
+	mongoReconnectInterval = 30 * time.Second
+	mongoConnectTimeout    = 5 * time.Second
+)
+
+var ErrDatabaseDisabled = errors.New("database integration disabled")
+
+// mongoDatabase 延迟连接的MongoDB，连接建立后断线由驱动自动重连
+type mongoDatabase struct {
+	mu          sync.Mutex
+	config      *DatabaseConfig
+	client      *mongo.Client
+	err         error
+	lastAttempt time.Time
+}
+
+var mongoDB = &mongoDatabase{}
+
+// InitDatabase 读取鹤思的数据库配置，不立即连接MongoDB
+// 配置文件不存在或未配置MongoDB地址(如使用内置数据库)时不启用MongoDB
+func InitDatabase(configPath string) {
+	config, err := LoadDBConfig(configPath)
+	if err != nil {
+		logrus.Infof("MongoDB integration disabled: %v", err)
+		return
+	}
+	if config.DbHost == "" {
+		logrus.Infof("MongoDB integration disabled: DbHost not configured in %v", configPath)
+		return
+	}
+
+	mongoDB.mu.Lock()
+	defer mongoDB.mu.Unlock()
+	mongoDB.config = config
+	mongoDB.client = nil
+	mongoDB.err = nil
+	mongoDB.lastAttempt = time.Time{}
+}
+
+// GetMongoDBClient 获取MongoDB客户端及配置，第一次使用时连接
+// 未启用时返回ErrDatabaseDisabled，连接失败后在重连间隔内直接返回上次的错误
+func GetMongoDBClient(ctx context.Context) (*mongo.Client, *DatabaseConfig, error) {
+	mongoDB.mu.Lock()
+	defer mongoDB.mu.Unlock()
+
+	if mongoDB.config == nil {
+		return nil, nil, ErrDatabaseDisabled
+	}
+	if mongoDB.client != nil {
+		return mongoDB.client, mongoDB.config, nil
+	}
+	if time.Since(mongoDB.lastAttempt) < mongoReconnectInterval {
+		return nil, nil, mongoDB.err
+	}
+
+	mongoDB.lastAttempt = time.Now()
+	client, err := createMongoClient(ctx, mongoDB.config)
+	if err != nil {
+		mongoDB.err = err
+		return nil, nil, err
+	}
+	mongoDB.client = client
+	mongoDB.err = nil
+	return client, mongoDB.config, nil
+}
+
+// CloseDatabase 断开MongoDB连接
+func CloseDatabase(ctx context.Context) error {
+	mongoDB.mu.Lock()
+	defer mongoDB.mu.Unlock()
+
+	if mongoDB.client == nil {
+		return nil
+	}
+	err := mongoDB.client.Disconnect(ctx)
+	mongoDB.client = nil
+	return err
+}
+
+// 创建 MongoDB 客户端
+func createMongoClient(ctx context.Context, config *DatabaseConfig) (*mongo.Client, error) {
+	// 构建连接字符串
+	uri := fmt.Sprintf("mongodb://%s:%s@%s:%d",
+		config.DbUser,
+		config.DbPassword,
+		config.DbHost,
+		config.DbPort)
+
+	// 设置客户端选项，MongoDB不可用时尽快返回错误
+	clientOptions := options.Client().ApplyURI(uri).
+		SetConnectTimeout(mongoConnectTimeout).
+		SetServerSelectionTimeout(mongoConnectTimeout)
+
+	// 如果配置了副本集名称
+	if config.DbReplSetName != "" {
+		clientOptions.SetReplicaSet(config.DbReplSetName)
+	}
+
+	// 连接到 MongoDB
+	client, err := mongo.Connect(ctx, clientOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to MongoDB: %v", err)
+	}
+
+	// 检查连接
+	err = client.Ping(ctx, nil)
+	if err != nil {
+		client.Disconnect(context.Background())
+		return nil, fmt.Errorf("MongoDB connection test failed: %v", err)
+	}
+
+	return client, nil
+}
@@ -0,0 +1,275 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+const (
+	// 取消作业后等待作业结束的默认最长时间
+	defaultCancelJobsWaitTimeout = 30 * time.Second
+	cancelJobsPollPeriod         = time.Second
+)
+
+var jobCancelConfig atomic.Pointer[JobCancelConfig]
+
+// SetJobCancelConfig 设置删除账户和用户时取消作业后的等待时间，可以在运行时重新加载
+func SetJobCancelConfig(config JobCancelConfig) {
+	jobCancelConfig.Store(&config)
+}
+
+// CancelJobsWaitTimeout 取消作业后等待作业结束的最长时间，请求未指定时使用配置的值，配置了最大值时不超过最大值
+func CancelJobsWaitTimeout(requestedSeconds uint32) time.Duration {
+	config := jobCancelConfig.Load()
+	if config == nil {
+		config = &JobCancelConfig{}
+	}
+
+	timeout := defaultCancelJobsWaitTimeout
+	if config.WaitTimeout > 0 {
+		timeout = time.Duration(config.WaitTimeout) * time.Second
+	}
+	if requestedSeconds > 0 {
+		timeout = time.Duration(requestedSeconds) * time.Second
+	}
+	if config.MaxWaitTimeout > 0 && timeout > time.Duration(config.MaxWaitTimeout)*time.Second {
+		timeout = time.Duration(config.MaxWaitTimeout) * time.Second
+	}
+	return timeout
+}
+
+// AccountUsersError 删除账户时部分用户移除失败，账户本身未被删除，Removed中的用户已经移出账户
+type AccountUsersError struct {
+	Account string
+	Removed []string
+	Failed  []string
+	Err     error
+}
+
+func (e *AccountUsersError) Error() string {
+	return fmt.Sprintf("account %v is not deleted, users %v removed, failed to remove users %v: %v", e.Account, e.Removed, e.Failed, e.Err)
+}
+
+func (e *AccountUsersError) Unwrap() error {
+	return e.Err
+}
+
+// ArchivedAccount 软删除时归档的账户信息
+type ArchivedAccount struct {
+	Account    json.RawMessage `json:"account"`
+	Users      []string        `json:"users"`
+	Grant      *PartitionGrant `json:"grant"`
+	Limit      *ResourceLimit  `json:"limit"`
+	ArchivedAt time.Time       `json:"archived_at"`
+}
+
+// GetUnfinishedTaskIdsByAccountName 获取账户下未结束(排队或运行中)的作业id
+func GetUnfinishedTaskIdsByAccountName(ctx context.Context, accountName string) ([]uint32, error) {
+	request := &craneProtos.QueryTasksInfoRequest{
+		FilterAccounts:              []string{accountName},
+		FilterTaskStates:            []craneProtos.TaskStatus{craneProtos.TaskStatus_Pending, craneProtos.TaskStatus_Running},
+		OptionIncludeCompletedTasks: false,
+		NumLimit:                    99999999,
+	}
+	response, err := CraneCtld.QueryTasksInfo(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if !response.GetOk() {
+		return nil, fmt.Errorf("query tasks of account %v failed", accountName)
+	}
+
+	var taskIds []uint32
+	for _, task := range response.GetTaskInfoList() {
+		taskIds = append(taskIds, task.GetTaskId())
+	}
+	return taskIds, nil
+}
+
+// CancelAccountTasks 取消账户下的作业并等待其结束，返回等待timeout后仍未结束的作业
+func CancelAccountTasks(ctx context.Context, accountName string, taskIds []uint32, timeout time.Duration) ([]uint32, error) {
+	request := &craneProtos.CancelTaskRequest{
+		OperatorUid:   0,
+		FilterTaskIds: taskIds,
+		FilterAccount: accountName,
+		FilterState:   craneProtos.TaskStatus_Invalid,
+	}
+	response, err := CraneCtld.CancelTask(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for i, taskId := range response.GetNotCancelledTasks() {
+		logrus.Warnf("CancelAccountTasks task %v of account %v not cancelled: %v", taskId, accountName, reasonAt(response.GetNotCancelledReasons(), i))
+	}
+
+	return waitTasksFinished(ctx, timeout, func() ([]uint32, error) {
+		return GetUnfinishedTaskIdsByAccountName(ctx, accountName)
+	})
+}
+
+// waitTasksFinished 等待作业结束，返回等待timeout后仍未结束的作业，调用方取消请求时立即返回
+func waitTasksFinished(ctx context.Context, timeout time.Duration, getUnfinished func() ([]uint32, error)) ([]uint32, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		remaining, err := getUnfinished()
+		if err != nil {
+			return nil, err
+		}
+		if len(remaining) == 0 || time.Now().After(deadline) {
+			return remaining, nil
+		}
+		select {
+		case <-time.After(cancelJobsPollPeriod):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// JoinTaskIds 将作业id用逗号拼接，用于错误信息的metadata
+func JoinTaskIds(taskIds []uint32) string {
+	ids := make([]string, 0, len(taskIds))
+	for _, taskId := range taskIds {
+		ids = append(ids, strconv.FormatUint(uint64(taskId), 10))
+	}
+	return strings.Join(ids, ",")
+}
+
+// ArchiveAccount 归档账户的信息，包括账户本身、用户、分区授予记录和资源限制
+func ArchiveAccount(ctx context.Context, account *craneProtos.AccountInfo) error {
+	if ArchivedAccountStore == nil {
+		return nil
+	}
+
+	content, err := protojson.Marshal(account)
+	if err != nil {
+		return err
+	}
+	grant, err := GetPartitionGrant(ctx, account)
+	if err != nil {
+		return err
+	}
+	limit, err := GetAccountLimit(account.GetName())
+	if err != nil {
+		return err
+	}
+
+	return ArchivedAccountStore.Put(account.GetName(), &ArchivedAccount{
+		Account:    content,
+		Users:      account.GetUsers(),
+		Grant:      grant,
+		Limit:      limit,
+		ArchivedAt: time.Now(),
+	})
+}
+
+// IsAccountArchived 账户是否已被软删除归档
+func IsAccountArchived(accountName string) (bool, error) {
+	if ArchivedAccountStore == nil {
+		return false, nil
+	}
+	return ArchivedAccountStore.Get(accountName, &ArchivedAccount{})
+}
+
+// CheckAccountNotArchived 已归档的账户不能再解封或添加用户，只能彻底删除
+func CheckAccountNotArchived(accountName string) error {
+	archived, err := IsAccountArchived(accountName)
+	if err != nil {
+		return err
+	}
+	if archived {
+		return fmt.Errorf("%w: %v", ErrAccountArchived, accountName)
+	}
+	return nil
+}
+
+// FilterArchivedAccounts 去掉已归档的账户，列出账户时使用
+func FilterArchivedAccounts(accounts []*craneProtos.AccountInfo) []*craneProtos.AccountInfo {
+	if ArchivedAccountStore == nil {
+		return accounts
+	}
+	archived := ArchivedAccountStore.Keys()
+	var result []*craneProtos.AccountInfo
+	for _, account := range accounts {
+		if !Contains(archived, account.GetName()) {
+			result = append(result, account)
+		}
+	}
+	return result
+}
+
+// FilterArchivedAccountNames 同FilterArchivedAccounts，用于只有账户名的列表
+func FilterArchivedAccountNames(accountNames []string) []string {
+	if ArchivedAccountStore == nil {
+		return accountNames
+	}
+	return SliceSubtract(accountNames, ArchivedAccountStore.Keys())
+}
+
+// DeleteAccount 删除账户，先移除账户下的用户，再删除账户本身以及适配器中的记录
+func DeleteAccount(ctx context.Context, accountName string) error {
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+	if len(account.GetChildAccounts()) != 0 {
+		return fmt.Errorf("account %v has child accounts %v", accountName, account.GetChildAccounts())
+	}
+
+	// 移除失败的用户不影响其他用户，全部尝试后再返回哪些用户已被移除
+	usersErr := &AccountUsersError{Account: accountName}
+	var errs []error
+	for _, user := range account.GetUsers() {
+		if err = DeleteUserFromAccount(ctx, user, accountName); err != nil {
+			usersErr.Failed = append(usersErr.Failed, user)
+			errs = append(errs, fmt.Errorf("remove user %v: %v", user, err))
+			continue
+		}
+		usersErr.Removed = append(usersErr.Removed, user)
+		DeleteUserRecords(user, accountName)
+	}
+	if len(errs) != 0 {
+		usersErr.Err = errors.Join(errs...)
+		return usersErr
+	}
+
+	request := &craneProtos.DeleteAccountRequest{
+		Uid:         uint32(os.Getuid()),
+		AccountList: []string{accountName},
+	}
+	response, err := CraneCtld.DeleteAccount(ctx, request)
+	if err != nil {
+		return err
+	}
+	if !response.GetOk() {
+		return fmt.Errorf("delete account %v failed: %v", accountName, richErrorMessage(response.GetRichErrorList()))
+	}
+
+	if err = DeletePartitionGrant(accountName); err != nil {
+		logrus.Warnf("DeleteAccount delete partition grant of %v failed: %v", accountName, err)
+	}
+	if err = DeleteAccountLimits(accountName); err != nil {
+		logrus.Warnf("DeleteAccount delete limits of %v failed: %v", accountName, err)
+	}
+	if err = DeleteCascadeBlock(accountName); err != nil {
+		logrus.Warnf("DeleteAccount delete cascade block of %v failed: %v", accountName, err)
+	}
+	if ArchivedAccountStore != nil {
+		if err = ArchivedAccountStore.Delete(accountName); err != nil {
+			logrus.Warnf("DeleteAccount delete archive of %v failed: %v", accountName, err)
+		}
+	}
+	return nil
+}
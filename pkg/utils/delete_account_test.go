@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+func TestCancelJobsWaitTimeout(t *testing.T) {
+	t.Cleanup(func() { SetJobCancelConfig(JobCancelConfig{}) })
+
+	SetJobCancelConfig(JobCancelConfig{})
+	assert.Equal(t, defaultCancelJobsWaitTimeout, CancelJobsWaitTimeout(0))
+	assert.Equal(t, 5*time.Second, CancelJobsWaitTimeout(5))
+
+	SetJobCancelConfig(JobCancelConfig{WaitTimeout: 60, MaxWaitTimeout: 120})
+	assert.Equal(t, 60*time.Second, CancelJobsWaitTimeout(0))
+	assert.Equal(t, 120*time.Second, CancelJobsWaitTimeout(600))
+}
+
+func TestArchivedAccountIsRejectedAndHidden(t *testing.T) {
+	stub := newFakeCraneCtld(
+		&craneProtos.AccountInfo{Name: "a", Blocked: true},
+		&craneProtos.AccountInfo{Name: "b"},
+	)
+	useFakeCraneCtld(t, stub)
+	ctx := context.Background()
+
+	require.NoError(t, ArchiveAccount(ctx, stub.account("a")))
+
+	// 已归档的账户只能彻底删除，不能解封或添加用户
+	assert.ErrorIs(t, UnblockAccount(ctx, "a"), ErrAccountArchived)
+	assert.ErrorIs(t, AddUserToAccount(ctx, "a", "user1"), ErrAccountArchived)
+	assert.True(t, stub.account("a").GetBlocked())
+
+	accounts := FilterArchivedAccounts([]*craneProtos.AccountInfo{stub.account("a"), stub.account("b")})
+	require.Len(t, accounts, 1)
+	assert.Equal(t, "b", accounts[0].GetName())
+	assert.Equal(t, []string{"b"}, FilterArchivedAccountNames([]string{"a", "b"}))
+}
+
+func TestCancelAccountTasksWithMissingReasons(t *testing.T) {
+	stub := newFakeCraneCtld(&craneProtos.AccountInfo{Name: "a"})
+	// CraneCtld返回的原因少于未取消的作业时不能越界
+	stub.cancelTaskReply = &craneProtos.CancelTaskReply{NotCancelledTasks: []uint32{1, 2}, NotCancelledReasons: []string{"finished"}}
+	useFakeCraneCtld(t, stub)
+
+	remaining, err := CancelAccountTasks(context.Background(), "a", []uint32{1, 2}, time.Second)
+	require.NoError(t, err)
+	assert.Empty(t, remaining)
+	assert.Equal(t, 1, stub.callCount("CancelTask"))
+}
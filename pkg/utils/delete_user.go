@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// UserAccountDeleteResult 强制删除用户时从单个账户中移除用户的结果
+type UserAccountDeleteResult struct {
+	AccountName string
+	Err         error
+}
+
+// GetUnfinishedTaskIdsByUserName 获取用户未结束(排队或运行中)的作业id
+func GetUnfinishedTaskIdsByUserName(ctx context.Context, userName string) ([]uint32, error) {
+	request := &craneProtos.QueryTasksInfoRequest{
+		FilterUsers:                 []string{userName},
+		FilterTaskStates:            []craneProtos.TaskStatus{craneProtos.TaskStatus_Pending, craneProtos.TaskStatus_Running},
+		OptionIncludeCompletedTasks: false,
+		NumLimit:                    99999999,
+	}
+	response, err := CraneCtld.QueryTasksInfo(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if !response.GetOk() {
+		return nil, fmt.Errorf("query tasks of user %v failed", userName)
+	}
+
+	var taskIds []uint32
+	for _, task := range response.GetTaskInfoList() {
+		taskIds = append(taskIds, task.GetTaskId())
+	}
+	return taskIds, nil
+}
+
+// CancelUserTasks 以适配器的身份取消用户的作业并等待结束，返回等待timeout后仍未结束的作业
+// 不查询用户本人的uid，已离职、身份源中查不到的用户同样可以删除
+func CancelUserTasks(ctx context.Context, userName string, taskIds []uint32, timeout time.Duration) ([]uint32, error) {
+	request := &craneProtos.CancelTaskRequest{
+		OperatorUid:    uint32(os.Getuid()),
+		FilterTaskIds:  taskIds,
+		FilterUsername: userName,
+		FilterState:    craneProtos.TaskStatus_Invalid,
+	}
+	response, err := CraneCtld.CancelTask(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for i, taskId := range response.GetNotCancelledTasks() {
+		logrus.Warnf("CancelUserTasks task %v of user %v not cancelled: %v", taskId, userName, reasonAt(response.GetNotCancelledReasons(), i))
+	}
+
+	return waitTasksFinished(ctx, timeout, func() ([]uint32, error) {
+		return GetUnfinishedTaskIdsByUserName(ctx, userName)
+	})
+}
+
+// GetUserAccounts 获取用户所在的所有账户
+func GetUserAccounts(ctx context.Context, userName string) ([]string, error) {
+	accounts, err := GetAllAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var accountNames []string
+	for _, account := range accounts {
+		if Contains(account.GetUsers(), userName) {
+			accountNames = append(accountNames, account.GetName())
+		}
+	}
+	return accountNames, nil
+}
+
+// DeleteUserFromAllAccounts 将用户从所在的每个账户中移除，并清除适配器中的相关记录，返回每个账户的结果
+func DeleteUserFromAllAccounts(ctx context.Context, userName string) ([]*UserAccountDeleteResult, error) {
+	accountNames, err := GetUserAccounts(ctx, userName)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*UserAccountDeleteResult
+	for _, accountName := range accountNames {
+		err = DeleteUserFromAccount(ctx, userName, accountName)
+		results = append(results, &UserAccountDeleteResult{AccountName: accountName, Err: err})
+		if err != nil {
+			logrus.Errorf("DeleteUserFromAllAccounts remove user %v from account %v failed: %v", userName, accountName, err)
+			continue
+		}
+
+		DeleteUserRecords(userName, accountName)
+	}
+	return results, nil
+}
+
+// DeleteUserRecords 删除适配器中用户在账户下的单独设置、分区封锁和资源限制记录，用户移出账户后调用，失败时只记录日志
+func DeleteUserRecords(userName, accountName string) {
+	if err := DeleteUserPartitionQos(userName, accountName); err != nil {
+		logrus.Warnf("delete partition qos override of user %v in account %v failed: %v", userName, accountName, err)
+	}
+	if err := DeleteUserBlockedPartitions(userName, accountName); err != nil {
+		logrus.Warnf("delete blocked partitions of user %v in account %v failed: %v", userName, accountName, err)
+	}
+	if err := DeleteUserLimit(userName, accountName); err != nil {
+		logrus.Warnf("delete limits of user %v in account %v failed: %v", userName, accountName, err)
+	}
+}
+
+// DeleteUserAllRecords 删除适配器中用户在所有账户下的记录，从鹤思中删除用户后调用
+func DeleteUserAllRecords(userName string) {
+	accountNames := map[string]bool{}
+	for _, store := range []*FileStore{UserOverrideStore, UserBlockedPartitionStore, UserLimitStore} {
+		if store == nil {
+			continue
+		}
+		// 这些记录的key都是 账户/用户
+		for _, key := range store.Keys() {
+			if accountName, user, ok := strings.Cut(key, "/"); ok && user == userName {
+				accountNames[accountName] = true
+			}
+		}
+	}
+	for accountName := range accountNames {
+		DeleteUserRecords(userName, accountName)
+	}
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+func TestDeleteUserAllRecords(t *testing.T) {
+	useFakeCraneCtld(t, newFakeCraneCtld())
+
+	maxJobs := uint64(10)
+	for _, accountName := range []string{"a", "b"} {
+		require.NoError(t, UserOverrideStore.Put(userOverrideKey("u1", accountName), &UserPartitionQos{QosList: []string{"q1"}}))
+		require.NoError(t, saveUserBlockedPartitions("u1", accountName, []string{"p1"}))
+		require.NoError(t, UserLimitStore.Put(userLimitKey("u1", accountName), &ResourceLimit{MaxJobs: &maxJobs}))
+	}
+	require.NoError(t, saveUserBlockedPartitions("u2", "a", []string{"p1"}))
+
+	DeleteUserAllRecords("u1")
+
+	assert.Empty(t, UserOverrideStore.Keys())
+	assert.Empty(t, UserLimitStore.Keys())
+	// 其他用户的记录保留
+	assert.Equal(t, []string{userOverrideKey("u2", "a")}, UserBlockedPartitionStore.Keys())
+}
+
+// failingIdentityProvider 查询任何用户都失败的身份源，模拟已离职的用户
+type failingIdentityProvider struct{}
+
+func (failingIdentityProvider) LookupUid(ctx context.Context, userName string) (uint32, error) {
+	return 0, ErrUserNotFound
+}
+
+func TestDeleteUserWithoutIdentity(t *testing.T) {
+	defer func(identity IdentityProvider) { Identity = identity }(Identity)
+	Identity = failingIdentityProvider{}
+
+	stub := newFakeCraneCtld(
+		&craneProtos.AccountInfo{Name: "a", Users: []string{"u1", "u2"}},
+		&craneProtos.AccountInfo{Name: "b", Users: []string{"u1"}},
+	)
+	stub.tasks = []*craneProtos.TaskInfo{{TaskId: 1}}
+	useFakeCraneCtld(t, stub)
+	ctx := context.Background()
+
+	// 身份源中查不到用户时仍以适配器的身份取消作业并移出所有账户
+	remaining, err := CancelUserTasks(ctx, "u1", []uint32{1}, time.Second)
+	require.NoError(t, err)
+	assert.Empty(t, remaining)
+	assert.Equal(t, uint32(os.Getuid()), stub.lastCancelTask.GetOperatorUid())
+
+	results, err := DeleteUserFromAllAccounts(ctx, "u1")
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		assert.NoError(t, result.Err, result.AccountName)
+	}
+	assert.Equal(t, []string{"u2"}, stub.account("a").GetUsers())
+	assert.Empty(t, stub.account("b").GetUsers())
+}
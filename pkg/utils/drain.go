@@ -0,0 +1,297 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// nodeDrainCheckInterval 检查排空计划是否到达开始时间、节点上的作业是否结束的间隔
+const nodeDrainCheckInterval = 30 * time.Second
+
+var (
+	ErrNodeDrainNotFound = errors.New("node drain not found")
+	// ErrNodeDrainIllegal 排空请求不合法，如节点为空或不存在
+	ErrNodeDrainIllegal = errors.New("illegal node drain")
+	// ErrNodeAlreadyInDrain 节点已属于其他未取消的排空计划
+	ErrNodeAlreadyInDrain = errors.New("node already in drain")
+	// ErrNodeDrainStore 读写保存排空计划的文件失败
+	ErrNodeDrainStore = errors.New("node drain store failed")
+)
+
+// NodeDrain 节点排空计划，保存在NodeDrainStore中，适配器重启后继续执行
+type NodeDrain struct {
+	Id        string                        `json:"id"`
+	Nodes     []string                      `json:"nodes"`
+	StartTime time.Time                     `json:"start_time"`
+	Reason    string                        `json:"reason"`
+	Status    adapterProtos.NodeDrainStatus `json:"status"`
+	Message   string                        `json:"message,omitempty"`
+	DrainedAt *time.Time                    `json:"drained_at,omitempty"`
+}
+
+// 保证同一时刻只有一个请求或检查在修改排空计划，调用CraneCtld期间也持有
+// 读取排空计划不需要持有，NodeDrainStore本身是并发安全的
+var nodeDrainMu sync.Mutex
+
+// ScheduleNodeDrain 添加排空计划，节点不能同时属于其他未取消的计划
+func ScheduleNodeDrain(ctx context.Context, nodes []string, startTime time.Time, reason string) (*NodeDrain, error) {
+	nodeDrainMu.Lock()
+	defer nodeDrainMu.Unlock()
+
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("%w: nodes is empty", ErrNodeDrainIllegal)
+	}
+	allNodes, err := getAllNodeNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if notExist := SliceSubtract(nodes, allNodes); len(notExist) != 0 {
+		return nil, fmt.Errorf("%w: nodes %v not found", ErrNodeDrainIllegal, notExist)
+	}
+
+	drains, err := getNodeDrains()
+	if err != nil {
+		return nil, err
+	}
+	for _, drain := range drains {
+		if drain.Status == adapterProtos.NodeDrainStatus_DRAIN_FAILED {
+			continue
+		}
+		for _, node := range nodes {
+			if Contains(drain.Nodes, node) {
+				return nil, fmt.Errorf("%w: node %v in %v", ErrNodeAlreadyInDrain, node, drain.Id)
+			}
+		}
+	}
+
+	drain := &NodeDrain{
+		Id:        "drain-" + strconv.FormatInt(time.Now().UnixNano(), 10),
+		Nodes:     nodes,
+		StartTime: startTime,
+		Reason:    reason,
+		Status:    adapterProtos.NodeDrainStatus_DRAIN_PENDING,
+	}
+	if err = NodeDrainStore.Put(drain.Id, drain); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNodeDrainStore, err)
+	}
+	return drain, nil
+}
+
+// CancelNodeDrain 取消排空计划，已经排空的节点恢复为可调度，失败的计划只删除记录
+func CancelNodeDrain(ctx context.Context, drainId string) error {
+	nodeDrainMu.Lock()
+	defer nodeDrainMu.Unlock()
+
+	drain := &NodeDrain{}
+	exist, err := NodeDrainStore.Get(drainId, drain)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrNodeDrainStore, err)
+	}
+	if !exist {
+		return fmt.Errorf("%w: %v", ErrNodeDrainNotFound, drainId)
+	}
+
+	if drain.Status == adapterProtos.NodeDrainStatus_DRAINING || drain.Status == adapterProtos.NodeDrainStatus_DRAINED {
+		if err = modifyNodeState(ctx, drain.Nodes, craneProtos.CranedControlState_CRANE_NONE, ""); err != nil {
+			return err
+		}
+	}
+	if err = NodeDrainStore.Delete(drainId); err != nil {
+		return fmt.Errorf("%w: %v", ErrNodeDrainStore, err)
+	}
+	return nil
+}
+
+// GetNodeDrains 获取所有排空计划，按id即创建时间排列
+// 不持有nodeDrainMu，不会因为排空检查正在调用CraneCtld而阻塞
+func GetNodeDrains() ([]*NodeDrain, error) {
+	return getNodeDrains()
+}
+
+func getNodeDrains() ([]*NodeDrain, error) {
+	if NodeDrainStore == nil {
+		return nil, nil
+	}
+	var drains []*NodeDrain
+	for _, key := range NodeDrainStore.Keys() {
+		drain := &NodeDrain{}
+		exist, err := NodeDrainStore.Get(key, drain)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrNodeDrainStore, err)
+		}
+		// 读取期间被取消的计划
+		if !exist {
+			continue
+		}
+		drains = append(drains, drain)
+	}
+	return drains, nil
+}
+
+// StartNodeDrainScheduler 定期执行到达开始时间的排空计划，并检查排空中的节点上的作业是否结束
+// ctx结束时停止，正在进行的检查不会被中断，避免排空计划因调用被取消而失败
+func StartNodeDrainScheduler(ctx context.Context) {
+	go func() {
+		// 启动后先执行一次，补上适配器停止期间到达开始时间的计划
+		processNodeDrains(context.Background())
+
+		ticker := time.NewTicker(nodeDrainCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				processNodeDrains(context.Background())
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func processNodeDrains(ctx context.Context) {
+	nodeDrainMu.Lock()
+	defer nodeDrainMu.Unlock()
+
+	drains, err := getNodeDrains()
+	if err != nil {
+		logrus.Errorf("processNodeDrains read drains failed: %v", err)
+		return
+	}
+
+	var runningTaskNum map[string]uint32
+	for _, drain := range drains {
+		switch drain.Status {
+		case adapterProtos.NodeDrainStatus_DRAIN_PENDING:
+			if time.Now().Before(drain.StartTime) {
+				continue
+			}
+			if err = modifyNodeState(ctx, drain.Nodes, craneProtos.CranedControlState_CRANE_DRAIN, drain.Reason); err != nil {
+				logrus.Errorf("processNodeDrains drain %v failed: %v", drain.Id, err)
+				drain.Status = adapterProtos.NodeDrainStatus_DRAIN_FAILED
+				drain.Message = err.Error()
+			} else {
+				logrus.Infof("processNodeDrains drain %v nodes %v draining", drain.Id, drain.Nodes)
+				drain.Status = adapterProtos.NodeDrainStatus_DRAINING
+			}
+		case adapterProtos.NodeDrainStatus_DRAINING:
+			if runningTaskNum == nil {
+				if runningTaskNum, err = getNodeRunningTaskNum(ctx); err != nil {
+					logrus.Errorf("processNodeDrains query nodes failed: %v", err)
+					return
+				}
+			}
+			if !nodesEmpty(drain.Nodes, runningTaskNum) {
+				continue
+			}
+			logrus.Infof("processNodeDrains drain %v nodes %v drained, ready for maintenance", drain.Id, drain.Nodes)
+			drainedAt := time.Now()
+			drain.Status = adapterProtos.NodeDrainStatus_DRAINED
+			drain.DrainedAt = &drainedAt
+		default:
+			continue
+		}
+
+		if err = NodeDrainStore.Put(drain.Id, drain); err != nil {
+			logrus.Errorf("processNodeDrains save drain %v failed: %v", drain.Id, err)
+		}
+	}
+}
+
+func nodesEmpty(nodes []string, runningTaskNum map[string]uint32) bool {
+	for _, node := range nodes {
+		if runningTaskNum[node] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func modifyNodeState(ctx context.Context, nodes []string, state craneProtos.CranedControlState, reason string) error {
+	request := &craneProtos.ModifyCranedStateRequest{
+		Uid:       uint32(os.Getuid()),
+		CranedIds: nodes,
+		NewState:  state,
+		Reason:    reason,
+	}
+	response, err := CraneCtld.ModifyNode(ctx, request)
+	if err != nil {
+		return err
+	}
+	if len(response.GetNotModifiedNodes()) != 0 {
+		var reasons []string
+		for i, node := range response.GetNotModifiedNodes() {
+			reasons = append(reasons, fmt.Sprintf("%v: %v", node, reasonAt(response.GetNotModifiedReasons(), i)))
+		}
+		return fmt.Errorf("modify nodes to %v failed: %v", state, strings.Join(reasons, "; "))
+	}
+	return nil
+}
+
+func getNodeRunningTaskNum(ctx context.Context) (map[string]uint32, error) {
+	response, err := CraneCtld.QueryCranedInfo(ctx, &craneProtos.QueryCranedInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+	runningTaskNum := make(map[string]uint32)
+	for _, node := range response.GetCranedInfoList() {
+		runningTaskNum[node.GetHostname()] = node.GetRunningTaskNum()
+	}
+	return runningTaskNum, nil
+}
+
+func getAllNodeNames(ctx context.Context) ([]string, error) {
+	runningTaskNum, err := getNodeRunningTaskNum(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for name := range runningTaskNum {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// GetNodeMaintenanceStates 获取节点的排空状态，包括在适配器之外排空的节点
+func GetNodeMaintenanceStates(nodes []*craneProtos.CranedInfo) ([]*adapterProtos.ListNodeMaintenanceStatesResponse_NodeMaintenance, error) {
+	drains, err := GetNodeDrains()
+	if err != nil {
+		return nil, err
+	}
+	nodeDrains := make(map[string]*NodeDrain)
+	for _, drain := range drains {
+		for _, node := range drain.Nodes {
+			nodeDrains[node] = drain
+		}
+	}
+
+	var states []*adapterProtos.ListNodeMaintenanceStatesResponse_NodeMaintenance
+	for _, node := range nodes {
+		drained := node.GetControlState() == craneProtos.CranedControlState_CRANE_DRAIN
+		drain, scheduled := nodeDrains[node.GetHostname()]
+		if !drained && !scheduled {
+			continue
+		}
+		state := &adapterProtos.ListNodeMaintenanceStatesResponse_NodeMaintenance{
+			NodeName: node.GetHostname(),
+			Drained:  drained,
+		}
+		if scheduled {
+			state.Reason = drain.Reason
+			state.DrainId = &drain.Id
+			state.Status = &drain.Status
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+func newDrainStub() *fakeCraneCtld {
+	stub := newFakeCraneCtld()
+	stub.nodes = []*craneProtos.CranedInfo{cranedInfo("cn01", 32, 1024, "cpu"), cranedInfo("cn02", 32, 1024, "cpu")}
+	return stub
+}
+
+func TestScheduleNodeDrainErrors(t *testing.T) {
+	useFakeCraneCtld(t, newDrainStub())
+	ctx := context.Background()
+
+	_, err := ScheduleNodeDrain(ctx, nil, time.Now(), "")
+	assert.ErrorIs(t, err, ErrNodeDrainIllegal)
+	_, err = ScheduleNodeDrain(ctx, []string{"cn01", "missing"}, time.Now(), "")
+	assert.ErrorIs(t, err, ErrNodeDrainIllegal)
+
+	_, err = ScheduleNodeDrain(ctx, []string{"cn01"}, time.Now().Add(time.Hour), "maintenance")
+	require.NoError(t, err)
+	_, err = ScheduleNodeDrain(ctx, []string{"cn02", "cn01"}, time.Now(), "")
+	assert.ErrorIs(t, err, ErrNodeAlreadyInDrain)
+}
+
+func TestScheduleNodeDrainCraneFailure(t *testing.T) {
+	useFakeCraneCtld(t, &craneProtos.UnimplementedCraneCtldServer{})
+
+	// 查询节点失败不是请求错误
+	_, err := ScheduleNodeDrain(context.Background(), []string{"cn01"}, time.Now(), "")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNodeDrainIllegal)
+	assert.NotErrorIs(t, err, ErrNodeAlreadyInDrain)
+	assert.NotErrorIs(t, err, ErrNodeDrainStore)
+}
+
+func TestModifyNodeStateMissingReasons(t *testing.T) {
+	stub := newDrainStub()
+	stub.modifyNodeReply = &craneProtos.ModifyCranedStateReply{NotModifiedNodes: []string{"cn01", "cn02"}, NotModifiedReasons: []string{"busy"}}
+	useFakeCraneCtld(t, stub)
+
+	err := modifyNodeState(context.Background(), []string{"cn01", "cn02"}, craneProtos.CranedControlState_CRANE_DRAIN, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cn01: busy")
+	assert.Contains(t, err.Error(), "cn02: unknown reason")
+}
+
+func TestNodeDrainReadsNotBlockedByProcessing(t *testing.T) {
+	stub := newDrainStub()
+	stub.modifyNodeGate = make(chan struct{})
+	useFakeCraneCtld(t, stub)
+
+	_, err := ScheduleNodeDrain(context.Background(), []string{"cn01"}, time.Now(), "maintenance")
+	require.NoError(t, err)
+
+	processed := make(chan struct{})
+	go func() {
+		processNodeDrains(context.Background())
+		close(processed)
+	}()
+	require.Eventually(t, func() bool { return stub.callCount("ModifyNode") == 1 }, time.Second, 10*time.Millisecond)
+
+	// 排空检查调用CraneCtld期间可以读取排空状态
+	read := make(chan []*adapterProtos.ListNodeMaintenanceStatesResponse_NodeMaintenance)
+	go func() {
+		states, _ := GetNodeMaintenanceStates(stub.nodes)
+		read <- states
+	}()
+	select {
+	case states := <-read:
+		require.Len(t, states, 1)
+		assert.Equal(t, adapterProtos.NodeDrainStatus_DRAIN_PENDING, states[0].GetStatus())
+	case <-time.After(time.Second):
+		t.Fatal("GetNodeMaintenanceStates blocked by processNodeDrains")
+	}
+
+	close(stub.modifyNodeGate)
+	<-processed
+	drains, err := GetNodeDrains()
+	require.NoError(t, err)
+	assert.Equal(t, adapterProtos.NodeDrainStatus_DRAINING, drains[0].Status)
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+)
+
+// 检查请求时发现的错误，不是调用CraneCtld失败，服务按错误类型返回对应的错误码，客户端不应重试
+var (
+	ErrAccountNotFound   = errors.New("account not found")
+	ErrPartitionNotFound = errors.New("partition not found")
+	ErrQosNotFound       = errors.New("qos not found")
+	ErrInvalidQos        = errors.New("invalid qos")
+	ErrUserNotInAccount  = errors.New("user not in account")
+	ErrAccountArchived   = errors.New("account is archived")
+	ErrAccountExists     = errors.New("account already exists")
+
+	// ErrPartitionNotGranted 分区存在但未授予账户，需要先授予分区
+	ErrPartitionNotGranted = errors.New("partition not granted to account")
+	ErrQosNotAllowed       = errors.New("qos not allowed in account")
+
+	// ErrCoordinatorChangeUnsupported 鹤思不支持修改已在账户中的用户的协调者身份，需要将用户移出账户后重新添加
+	ErrCoordinatorChangeUnsupported = errors.New("crane does not support changing the coordinator of a user in an account")
+)
+
+// ServiceError 将utils返回的错误转换为返回给scow的错误，请求错误按类型返回，其他错误视为调用CraneCtld失败
+func ServiceError(err error) error {
+	switch {
+	case errors.Is(err, ErrAccountNotFound):
+		return RichError(codes.NotFound, "ACCOUNT_NOT_FOUND", err.Error())
+	case errors.Is(err, ErrPartitionNotFound):
+		return RichError(codes.InvalidArgument, "PARTITION_NOT_FOUND", err.Error())
+	case errors.Is(err, ErrQosNotFound):
+		return RichError(codes.InvalidArgument, "QOS_NOT_FOUND", err.Error())
+	case errors.Is(err, ErrInvalidQos):
+		return RichError(codes.InvalidArgument, "QOS_ILLEGAL", err.Error())
+	case errors.Is(err, ErrUserNotInAccount):
+		return RichError(codes.NotFound, "USER_NOT_FOUND", err.Error())
+	case errors.Is(err, ErrPartitionNotGranted):
+		return RichError(codes.FailedPrecondition, "PARTITION_NOT_GRANTED", err.Error())
+	case errors.Is(err, ErrQosNotAllowed):
+		return RichError(codes.InvalidArgument, "QOS_NOT_ALLOWED", err.Error())
+	case errors.Is(err, ErrAccountArchived):
+		return RichError(codes.FailedPrecondition, "ACCOUNT_ARCHIVED", err.Error())
+	case errors.Is(err, ErrCoordinatorChangeUnsupported):
+		return RichError(codes.FailedPrecondition, "COORDINATOR_CHANGE_UNSUPPORTED", err.Error())
+	case errors.Is(err, ErrLimitExceedsCeiling):
+		return RichError(codes.InvalidArgument, "LIMIT_EXCEEDS_CEILING", err.Error())
+	}
+	return CraneCallError(err)
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceError(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{fmt.Errorf("%w: failed get account a", ErrAccountNotFound), codes.NotFound},
+		{fmt.Errorf("%w: p1", ErrPartitionNotFound), codes.InvalidArgument},
+		{fmt.Errorf("%w: q1", ErrQosNotFound), codes.InvalidArgument},
+		{fmt.Errorf("%w: default qos q1 is not in allowed qos list", ErrInvalidQos), codes.InvalidArgument},
+		{fmt.Errorf("%w: a", ErrAccountArchived), codes.FailedPrecondition},
+		{fmt.Errorf("%w: partition p1, account a", ErrPartitionNotGranted), codes.FailedPrecondition},
+		{fmt.Errorf("%w: qos q1, account a", ErrQosNotAllowed), codes.InvalidArgument},
+		{fmt.Errorf("%w: user u1, account a", ErrUserNotInAccount), codes.NotFound},
+		{status.Error(codes.DeadlineExceeded, "timeout"), codes.DeadlineExceeded},
+		{errors.New("connection refused"), codes.Unavailable},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.code, status.Code(ServiceError(test.err)), test.err.Error())
+	}
+}
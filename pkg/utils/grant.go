@@ -0,0 +1,230 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// PartitionGrant 记录账户被授予的分区以及其中被封锁的分区
+// 鹤思中账户只有AllowedPartitions一个字段，封锁分区是通过从中删除分区实现的，
+// 因此需要单独记录授予情况，解封时只恢复曾经授予过的分区
+type PartitionGrant struct {
+	Granted []string `json:"granted"`
+	Blocked []string `json:"blocked"`
+}
+
+// 保证同一时刻只有一个请求在读改写授予记录
+var grantMu sync.Mutex
+
+// GetPartitionGrant 获取账户的分区授予记录
+// 没有记录的账户是在记录功能之前创建的，当时账户会被授予所有分区，不在AllowedPartitions中的分区视为被封锁
+func GetPartitionGrant(ctx context.Context, account *craneProtos.AccountInfo) (*PartitionGrant, error) {
+	grant := &PartitionGrant{}
+	if PartitionGrantStore != nil {
+		exist, err := PartitionGrantStore.Get(account.GetName(), grant)
+		if err != nil {
+			return nil, err
+		}
+		if exist {
+			return grant, nil
+		}
+	}
+
+	grant.Granted = GetAllPartitions(ctx)
+	for _, partition := range account.GetAllowedPartitions() {
+		if !Contains(grant.Granted, partition) {
+			grant.Granted = append(grant.Granted, partition)
+		}
+	}
+	grant.Blocked = SliceSubtract(grant.Granted, account.GetAllowedPartitions())
+	return grant, nil
+}
+
+func savePartitionGrant(accountName string, grant *PartitionGrant) error {
+	if PartitionGrantStore == nil {
+		return nil
+	}
+	if err := PartitionGrantStore.Put(accountName, grant); err != nil {
+		logrus.Errorf("save partition grant of account %v failed: %v", accountName, err)
+		return err
+	}
+	return nil
+}
+
+// DeletePartitionGrant 删除账户的分区授予记录，账户删除后调用
+func DeletePartitionGrant(accountName string) error {
+	if PartitionGrantStore == nil {
+		return nil
+	}
+	return PartitionGrantStore.Delete(accountName)
+}
+
+// GrantAccountPartitions 给账户授予分区，已被授予的分区不做处理
+func GrantAccountPartitions(ctx context.Context, accountName string, partitions []string) error {
+	grantMu.Lock()
+	defer grantMu.Unlock()
+
+	if err := checkPartitionsExist(ctx, partitions); err != nil {
+		return err
+	}
+
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+	grant, err := GetPartitionGrant(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	newPartitions := SliceSubtract(partitions, grant.Granted)
+	if len(newPartitions) == 0 {
+		logrus.Infof("GrantAccountPartitions account %v already granted partitions %v", accountName, partitions)
+		return nil
+	}
+
+	needAddPartitions := SliceSubtract(newPartitions, account.GetAllowedPartitions())
+	if len(needAddPartitions) != 0 {
+		if err = addAccountPartitions(ctx, accountName, needAddPartitions); err != nil {
+			return err
+		}
+	}
+
+	grant.Granted = append(grant.Granted, newPartitions...)
+	return savePartitionGrant(accountName, grant)
+}
+
+// RevokeAccountPartitions 收回账户的分区，同时清除该分区的封锁记录
+func RevokeAccountPartitions(ctx context.Context, accountName string, partitions []string) error {
+	grantMu.Lock()
+	defer grantMu.Unlock()
+
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+	grant, err := GetPartitionGrant(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	var needDeletePartitions []string
+	for _, partition := range partitions {
+		if Contains(account.GetAllowedPartitions(), partition) {
+			needDeletePartitions = append(needDeletePartitions, partition)
+		}
+	}
+	if len(needDeletePartitions) != 0 {
+		if err = deleteAccountPartitions(ctx, accountName, needDeletePartitions); err != nil {
+			return err
+		}
+	}
+
+	grant.Granted = SliceSubtract(grant.Granted, partitions)
+	grant.Blocked = SliceSubtract(grant.Blocked, partitions)
+	return savePartitionGrant(accountName, grant)
+}
+
+// SetAccountQos 设置账户允许使用的qos及默认qos，并同步到账户下的所有用户
+func SetAccountQos(ctx context.Context, accountName string, qosList []string, defaultQos string) error {
+	if len(qosList) == 0 {
+		return fmt.Errorf("%w: allowed qos list is empty", ErrInvalidQos)
+	}
+	if defaultQos == "" {
+		defaultQos = qosList[0]
+	}
+	if !Contains(qosList, defaultQos) {
+		return fmt.Errorf("%w: default qos %v is not in allowed qos list %v", ErrInvalidQos, defaultQos, qosList)
+	}
+
+	systemQos, err := GetQos(ctx)
+	if err != nil {
+		return err
+	}
+	for _, qos := range qosList {
+		if !Contains(systemQos, qos) {
+			return fmt.Errorf("%w: %v", ErrQosNotFound, qos)
+		}
+	}
+
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+
+	// 默认qos必须在允许列表中，所以先添加新的qos，再修改默认qos，最后删除不再允许的qos
+	addQos := SliceSubtract(qosList, account.GetAllowedQosList())
+	deleteQos := SliceSubtract(account.GetAllowedQosList(), qosList)
+
+	if len(addQos) != 0 {
+		if err = modifyAccountField(ctx, accountName, craneProtos.ModifyField_Qos, craneProtos.OperationType_Add, addQos, false); err != nil {
+			return err
+		}
+	}
+	if account.GetDefaultQos() != defaultQos {
+		if err = modifyAccountField(ctx, accountName, craneProtos.ModifyField_DefaultQos, craneProtos.OperationType_Overwrite, []string{defaultQos}, false); err != nil {
+			return err
+		}
+	}
+	if len(deleteQos) != 0 {
+		if err = modifyAccountField(ctx, accountName, craneProtos.ModifyField_Qos, craneProtos.OperationType_Delete, deleteQos, true); err != nil {
+			return err
+		}
+	}
+
+	// 删除账户的qos时鹤思会一并删除用户的qos，这里只需要下发新增的qos和默认qos
+	users, err := getUsersByAccountName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+	if account, err = GetAccountByName(ctx, accountName); err != nil {
+		return err
+	}
+	for _, user := range users {
+		overridden, err := reapplyUserOverride(ctx, user.GetName(), account)
+		if err != nil {
+			return err
+		}
+		if overridden {
+			continue
+		}
+		if len(addQos) != 0 {
+			if err = modifyUserField(ctx, user.GetName(), accountName, "", craneProtos.ModifyField_Qos, craneProtos.OperationType_Add, addQos); err != nil {
+				return err
+			}
+		}
+		if err = modifyUserField(ctx, user.GetName(), accountName, "", craneProtos.ModifyField_DefaultQos, craneProtos.OperationType_Overwrite, []string{defaultQos}); err != nil {
+			return err
+		}
+	}
+
+	logrus.Infof("SetAccountQos account %v qos %v default %v success", accountName, qosList, defaultQos)
+	return nil
+}
+
+func checkPartitionsExist(ctx context.Context, partitions []string) error {
+	allPartitions := GetAllPartitions(ctx)
+	for _, partition := range partitions {
+		if !Contains(allPartitions, partition) {
+			return fmt.Errorf("%w: %v", ErrPartitionNotFound, partition)
+		}
+	}
+	return nil
+}
+
+// reapplyUserOverride 账户的qos变化后重新按用户的单独设置调整，用户没有单独设置时返回false
+func reapplyUserOverride(ctx context.Context, userName string, account *craneProtos.AccountInfo) (bool, error) {
+	userPartitionMu.Lock()
+	defer userPartitionMu.Unlock()
+
+	override, err := GetUserPartitionQos(userName, account.GetName())
+	if err != nil || override == nil {
+		return false, err
+	}
+	return true, applyUserPartitionQos(ctx, userName, account, override)
+}
@@ -0,0 +1,167 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+const (
+	defaultAccountDescription = "Create account in crane."
+	// 清理自动创建的父账户的超时，清理不受请求取消的影响
+	craneCleanupTimeout = 30 * time.Second
+)
+
+// ErrParentAccountBlocked 父账户处于封锁状态，子账户不能解封
+var ErrParentAccountBlocked = errors.New("parent account is blocked")
+
+// AccountTreeNode 账户树中的节点
+type AccountTreeNode struct {
+	Account  *craneProtos.AccountInfo
+	Children []*AccountTreeNode
+}
+
+// cascadeBlock 账户因祖先账户被封锁而一并封锁的记录，BlockedBy为发起封锁的祖先账户，该账户解封时一并解封
+type cascadeBlock struct {
+	BlockedBy string `json:"blocked_by"`
+}
+
+// getCascadeBlocker 获取连带封锁账户的祖先账户，账户不是被连带封锁时返回空
+func getCascadeBlocker(accountName string) (string, error) {
+	if CascadeBlockStore == nil {
+		return "", nil
+	}
+	record := &cascadeBlock{}
+	if _, err := CascadeBlockStore.Get(accountName, record); err != nil {
+		return "", err
+	}
+	return record.BlockedBy, nil
+}
+
+// setCascadeBlocker 记录连带封锁账户的祖先账户，blockedBy为空时删除记录
+func setCascadeBlocker(accountName, blockedBy string) error {
+	if CascadeBlockStore == nil {
+		return nil
+	}
+	if blockedBy == "" {
+		return CascadeBlockStore.Delete(accountName)
+	}
+	return CascadeBlockStore.Put(accountName, &cascadeBlock{BlockedBy: blockedBy})
+}
+
+// DeleteCascadeBlock 删除账户的连带封锁记录，账户删除后调用
+func DeleteCascadeBlock(accountName string) error {
+	return setCascadeBlocker(accountName, "")
+}
+
+// 保证同一时刻只有一个请求在自动创建父账户并在其下创建子账户
+var parentAccountMu sync.Mutex
+
+// ensureParentAccount 获取父账户，不存在时创建为顶层账户，created表示父账户是否为本次创建
+// 调用方需持有parentAccountMu，父账户被其他请求抢先创建时视为已存在
+func ensureParentAccount(ctx context.Context, parentAccount string) (parent *craneProtos.AccountInfo, created bool, err error) {
+	exist, err := SelectAccountExists(ctx, parentAccount)
+	if err != nil {
+		return nil, false, err
+	}
+	if !exist {
+		logrus.Infof("parent account %v not exists, create it", parentAccount)
+		err = CreateAccount(ctx, parentAccount, "", "")
+		switch {
+		case err == nil:
+			created = true
+		case errors.Is(err, ErrAccountExists):
+			logrus.Infof("parent account %v already created by another request", parentAccount)
+		default:
+			return nil, false, err
+		}
+	}
+	parent, err = GetAccountByName(ctx, parentAccount)
+	return parent, created, err
+}
+
+// GetDescendantAccounts 获取账户的所有子孙账户
+func GetDescendantAccounts(ctx context.Context, accountName string) ([]*craneProtos.AccountInfo, error) {
+	accounts, err := GetAllAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[string][]*craneProtos.AccountInfo)
+	for _, account := range accounts {
+		children[account.GetParentAccount()] = append(children[account.GetParentAccount()], account)
+	}
+
+	var descendants []*craneProtos.AccountInfo
+	visited := map[string]bool{accountName: true}
+	queue := []string{accountName}
+	for len(queue) != 0 {
+		for _, child := range children[queue[0]] {
+			if visited[child.GetName()] {
+				continue
+			}
+			visited[child.GetName()] = true
+			descendants = append(descendants, child)
+			queue = append(queue, child.GetName())
+		}
+		queue = queue[1:]
+	}
+	return descendants, nil
+}
+
+// GetAccountTree 获取以rootAccount为根的账户树，rootAccount为空时返回所有顶层账户的树，已归档的账户不在树中
+func GetAccountTree(ctx context.Context, rootAccount string) ([]*AccountTreeNode, error) {
+	accounts, err := GetAllAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accounts = FilterArchivedAccounts(accounts)
+
+	nodes := make(map[string]*AccountTreeNode)
+	for _, account := range accounts {
+		nodes[account.GetName()] = &AccountTreeNode{Account: account}
+	}
+
+	var roots []*AccountTreeNode
+	for _, account := range accounts {
+		node := nodes[account.GetName()]
+		parent, ok := nodes[account.GetParentAccount()]
+		if !ok {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	if rootAccount == "" {
+		return roots, nil
+	}
+	if node, ok := nodes[rootAccount]; ok {
+		return []*AccountTreeNode{node}, nil
+	}
+	return nil, nil
+}
+
+// getBlockedAncestor 沿父账户向上查找处于封锁状态的账户，不存在时返回空
+func getBlockedAncestor(ctx context.Context, accountName string) (string, error) {
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return "", err
+	}
+	visited := []string{accountName}
+	for parentName := account.GetParentAccount(); parentName != "" && !Contains(visited, parentName); parentName = account.GetParentAccount() {
+		account, err = GetAccountByName(ctx, parentName)
+		if err != nil {
+			return "", err
+		}
+		if account.GetBlocked() {
+			return parentName, nil
+		}
+		visited = append(visited, parentName)
+	}
+	return "", nil
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// newAccountTree 账户树 grand -> parent -> child
+func newAccountTree() *fakeCraneCtld {
+	return newFakeCraneCtld(
+		&craneProtos.AccountInfo{Name: "grand", ChildAccounts: []string{"parent"}},
+		&craneProtos.AccountInfo{Name: "parent", ParentAccount: "grand", ChildAccounts: []string{"child"}},
+		&craneProtos.AccountInfo{Name: "child", ParentAccount: "parent"},
+	)
+}
+
+func assertBlocked(t *testing.T, stub *fakeCraneCtld, expected map[string]bool) {
+	for name, blocked := range expected {
+		assert.Equal(t, blocked, stub.account(name).GetBlocked(), name)
+	}
+}
+
+func TestUnblockAccountUndoesCascadeBlock(t *testing.T) {
+	stub := newAccountTree()
+	useFakeCraneCtld(t, stub)
+	ctx := context.Background()
+
+	require.NoError(t, BlockAccount(ctx, "grand"))
+	assertBlocked(t, stub, map[string]bool{"grand": true, "parent": true, "child": true})
+
+	// 被连带封锁的子账户不能单独解封
+	assert.ErrorIs(t, UnblockAccount(ctx, "child"), ErrParentAccountBlocked)
+
+	require.NoError(t, UnblockAccount(ctx, "grand"))
+	assertBlocked(t, stub, map[string]bool{"grand": false, "parent": false, "child": false})
+}
+
+func TestUnblockAccountKeepsDirectlyBlockedChildren(t *testing.T) {
+	stub := newAccountTree()
+	useFakeCraneCtld(t, stub)
+	ctx := context.Background()
+
+	// 子账户在祖先封锁前已被直接封锁，祖先解封后保持封锁
+	require.NoError(t, BlockAccount(ctx, "parent"))
+	require.NoError(t, BlockAccount(ctx, "grand"))
+	require.NoError(t, UnblockAccount(ctx, "grand"))
+	assertBlocked(t, stub, map[string]bool{"grand": false, "parent": true, "child": true})
+
+	require.NoError(t, UnblockAccount(ctx, "parent"))
+	assertBlocked(t, stub, map[string]bool{"parent": false, "child": false})
+
+	// 子账户在祖先封锁后被直接封锁，其下被连带封锁的账户改为随该子账户解封
+	require.NoError(t, BlockAccount(ctx, "grand"))
+	require.NoError(t, BlockAccount(ctx, "parent"))
+	require.NoError(t, UnblockAccount(ctx, "grand"))
+	assertBlocked(t, stub, map[string]bool{"grand": false, "parent": true, "child": true})
+
+	require.NoError(t, UnblockAccount(ctx, "parent"))
+	assertBlocked(t, stub, map[string]bool{"parent": false, "child": false})
+}
+
+func TestCreateAccountsUnderNewParentConcurrently(t *testing.T) {
+	stub := newFakeCraneCtld()
+	stub.qos = []*craneProtos.QosInfo{{Name: "normal"}}
+	useFakeCraneCtld(t, stub)
+	ctx := context.Background()
+
+	// 同时在不存在的父账户下创建子账户，父账户只创建一次且所有子账户都创建成功
+	children := []string{"a", "b", "c", "d"}
+	errs := make(chan error, len(children))
+	for _, child := range children {
+		go func(child string) {
+			errs <- CreateAccount(ctx, child, "parent", "")
+		}(child)
+	}
+	for range children {
+		assert.NoError(t, <-errs)
+	}
+
+	assert.Equal(t, len(children)+1, stub.callCount("AddAccount"))
+	assert.ElementsMatch(t, children, stub.account("parent").GetChildAccounts())
+	for _, child := range children {
+		assert.Equal(t, "parent", stub.account(child).GetParentAccount())
+	}
+
+	// 账户已存在时返回ErrAccountExists
+	assert.ErrorIs(t, CreateAccount(ctx, "a", "", ""), ErrAccountExists)
+}
@@ -0,0 +1,223 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	IdentityProviderNss    = "nss"
+	IdentityProviderUidMap = "uid-map"
+	IdentityProviderLdap   = "ldap"
+
+	defaultLdapUserFilter   = "(uid=%s)"
+	defaultLdapUidAttribute = "uidNumber"
+	// 连接和查询LDAP的默认超时(秒)
+	defaultLdapTimeout = 5
+)
+
+// ErrUserNotFound 所有身份源中都找不到该用户
+var ErrUserNotFound = errors.New("user not found")
+
+// IdentityProvider 根据用户名查询用户uid的身份源
+type IdentityProvider interface {
+	LookupUid(ctx context.Context, userName string) (uint32, error)
+}
+
+// Identity 当前使用的身份源，默认只查询本机NSS
+var Identity IdentityProvider = NssIdentityProvider{}
+
+// NssIdentityProvider 通过os/user查询本机NSS(passwd、sssd等)
+type NssIdentityProvider struct{}
+
+func (NssIdentityProvider) LookupUid(ctx context.Context, userName string) (uint32, error) {
+	u, err := user.Lookup(userName)
+	if err != nil {
+		var unknownUserError user.UnknownUserError
+		if errors.As(err, &unknownUserError) {
+			return 0, ErrUserNotFound
+		}
+		return 0, err
+	}
+	uid, err := strconv.ParseUint(u.Uid, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("parse uid %v of user %v failed: %v", u.Uid, userName, err)
+	}
+	return uint32(uid), nil
+}
+
+// UidMapIdentityProvider 从静态的用户名到uid映射文件中查询，文件为yaml格式，每行形如 user: 1000
+type UidMapIdentityProvider struct {
+	uids map[string]uint32
+}
+
+func NewUidMapIdentityProvider(path string) (*UidMapIdentityProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read uid map file %v failed: %v", path, err)
+	}
+	uids := make(map[string]uint32)
+	if err = yaml.Unmarshal(content, &uids); err != nil {
+		return nil, fmt.Errorf("parse uid map file %v failed: %v", path, err)
+	}
+	return &UidMapIdentityProvider{uids: uids}, nil
+}
+
+func (p *UidMapIdentityProvider) LookupUid(ctx context.Context, userName string) (uint32, error) {
+	uid, ok := p.uids[userName]
+	if !ok {
+		return 0, ErrUserNotFound
+	}
+	return uid, nil
+}
+
+// LdapConn 查询时用到的LDAP连接操作，*ldap.Conn实现了该接口，测试时可以替换为本地实现
+type LdapConn interface {
+	Bind(username, password string) error
+	Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error)
+	SetTimeout(timeout time.Duration)
+	Close()
+}
+
+// LdapIdentityProvider 从LDAP目录中查询用户的uid
+type LdapIdentityProvider struct {
+	config  LdapConfig
+	timeout time.Duration
+	dial    func(ctx context.Context) (LdapConn, error)
+}
+
+func NewLdapIdentityProvider(config LdapConfig) *LdapIdentityProvider {
+	return NewLdapIdentityProviderWithDialer(config, func(ctx context.Context) (LdapConn, error) {
+		// ldap.DialURL不接受context，使用context的截止时间作为连接超时
+		dialer := &net.Dialer{}
+		if deadline, ok := ctx.Deadline(); ok {
+			dialer.Deadline = deadline
+		}
+		return ldap.DialURL(config.Url, ldap.DialWithDialer(dialer))
+	})
+}
+
+// NewLdapIdentityProviderWithDialer 使用指定的方式建立LDAP连接
+func NewLdapIdentityProviderWithDialer(config LdapConfig, dial func(ctx context.Context) (LdapConn, error)) *LdapIdentityProvider {
+	if config.UserFilter == "" {
+		config.UserFilter = defaultLdapUserFilter
+	}
+	if config.UidAttribute == "" {
+		config.UidAttribute = defaultLdapUidAttribute
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultLdapTimeout
+	}
+	return &LdapIdentityProvider{config: config, timeout: time.Duration(config.Timeout) * time.Second, dial: dial}
+}
+
+// LookupUid 连接、绑定和查询总共不超过配置的超时，ctx被取消时关闭连接中断查询
+func (p *LdapIdentityProvider) LookupUid(ctx context.Context, userName string) (uint32, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	conn, err := p.dial(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("connect ldap %v failed: %v", p.config.Url, err)
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	conn.SetTimeout(time.Until(deadline))
+	stop := context.AfterFunc(ctx, conn.Close)
+	defer stop()
+
+	if p.config.BindDn != "" {
+		if err = conn.Bind(p.config.BindDn, p.config.BindPassword); err != nil {
+			return 0, ldapError(ctx, fmt.Errorf("bind ldap as %v failed: %v", p.config.BindDn, err))
+		}
+	}
+
+	request := ldap.NewSearchRequest(
+		p.config.BaseDn,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, int(p.timeout.Seconds()), false,
+		fmt.Sprintf(p.config.UserFilter, ldap.EscapeFilter(userName)),
+		[]string{p.config.UidAttribute},
+		nil,
+	)
+	result, err := conn.Search(request)
+	if err != nil {
+		return 0, ldapError(ctx, fmt.Errorf("search ldap for user %v failed: %v", userName, err))
+	}
+	if len(result.Entries) == 0 {
+		return 0, ErrUserNotFound
+	}
+	if len(result.Entries) > 1 {
+		return 0, fmt.Errorf("found %v ldap entries for user %v", len(result.Entries), userName)
+	}
+
+	value := result.Entries[0].GetAttributeValue(p.config.UidAttribute)
+	uid, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("parse ldap uid %q of user %v failed: %v", value, userName, err)
+	}
+	return uint32(uid), nil
+}
+
+// ldapError 连接因超时或请求取消被关闭时返回ctx的错误，便于调用方区分超时
+func ldapError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return fmt.Errorf("%w: %v", ctx.Err(), err)
+	}
+	return err
+}
+
+// ChainIdentityProvider 按顺序依次查询多个身份源，返回第一个找到的结果
+type ChainIdentityProvider []IdentityProvider
+
+func (c ChainIdentityProvider) LookupUid(ctx context.Context, userName string) (uint32, error) {
+	for _, provider := range c {
+		uid, err := provider.LookupUid(ctx, userName)
+		if err == nil {
+			return uid, nil
+		}
+		if !errors.Is(err, ErrUserNotFound) {
+			return 0, err
+		}
+	}
+	return 0, ErrUserNotFound
+}
+
+// InitIdentityProvider 根据配置初始化身份源，未配置时只使用本机NSS
+func InitIdentityProvider(config IdentityConfig) error {
+	providers := config.Providers
+	if len(providers) == 0 {
+		providers = []string{IdentityProviderNss}
+	}
+
+	var chain ChainIdentityProvider
+	for _, name := range providers {
+		switch name {
+		case IdentityProviderNss:
+			chain = append(chain, NssIdentityProvider{})
+		case IdentityProviderUidMap:
+			provider, err := NewUidMapIdentityProvider(config.UidMapFile)
+			if err != nil {
+				return err
+			}
+			chain = append(chain, provider)
+		case IdentityProviderLdap:
+			chain = append(chain, NewLdapIdentityProvider(config.Ldap))
+		default:
+			return fmt.Errorf("unknown identity provider %v", name)
+		}
+	}
+
+	logrus.Infof("identity providers: %v", providers)
+	Identity = chain
+	return nil
+}
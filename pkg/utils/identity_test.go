@@ -0,0 +1,189 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeLdapConn 本地的LDAP替身，按filter返回预设的条目
+type fakeLdapConn struct {
+	entries  map[string]*ldap.Entry
+	bindDn   string
+	password string
+	timeout  time.Duration
+	// 不为nil时Search阻塞到连接关闭
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (c *fakeLdapConn) Bind(username, password string) error {
+	if username != c.bindDn || password != c.password {
+		return ldap.NewError(ldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))
+	}
+	return nil
+}
+
+func (c *fakeLdapConn) Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	if c.closed != nil {
+		<-c.closed
+		return nil, ldap.NewError(ldap.ErrorNetwork, errors.New("connection closed"))
+	}
+	result := &ldap.SearchResult{}
+	if entry, ok := c.entries[searchRequest.Filter]; ok {
+		result.Entries = append(result.Entries, entry)
+	}
+	return result, nil
+}
+
+func (c *fakeLdapConn) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+}
+
+func (c *fakeLdapConn) Close() {
+	if c.closed != nil {
+		c.closeOnce.Do(func() { close(c.closed) })
+	}
+}
+
+func newFakeLdapProvider(conn *fakeLdapConn) *LdapIdentityProvider {
+	config := LdapConfig{
+		BindDn:       "cn=admin,dc=example,dc=com",
+		BindPassword: "secret",
+		BaseDn:       "ou=people,dc=example,dc=com",
+	}
+	return NewLdapIdentityProviderWithDialer(config, func(ctx context.Context) (LdapConn, error) {
+		return conn, nil
+	})
+}
+
+func TestLdapIdentityProvider(t *testing.T) {
+	conn := &fakeLdapConn{
+		bindDn:   "cn=admin,dc=example,dc=com",
+		password: "secret",
+		entries: map[string]*ldap.Entry{
+			"(uid=alice)": ldap.NewEntry("uid=alice,ou=people,dc=example,dc=com", map[string][]string{
+				"uidNumber": {"20001"},
+			}),
+			"(uid=broken)": ldap.NewEntry("uid=broken,ou=people,dc=example,dc=com", map[string][]string{
+				"uidNumber": {"not-a-number"},
+			}),
+		},
+	}
+	provider := newFakeLdapProvider(conn)
+
+	uid, err := provider.LookupUid(context.Background(), "alice")
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(20001), uid)
+
+	_, err = provider.LookupUid(context.Background(), "bob")
+	assert.ErrorIs(t, err, ErrUserNotFound)
+
+	_, err = provider.LookupUid(context.Background(), "broken")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrUserNotFound)
+
+	conn.password = "changed"
+	_, err = provider.LookupUid(context.Background(), "alice")
+	assert.Error(t, err)
+}
+
+func TestLdapIdentityProviderTimeout(t *testing.T) {
+	conn := &fakeLdapConn{
+		bindDn:   "cn=admin,dc=example,dc=com",
+		password: "secret",
+		closed:   make(chan struct{}),
+	}
+	provider := newFakeLdapProvider(conn)
+
+	// 查询不返回时在ctx到期后关闭连接并返回超时
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := provider.LookupUid(ctx, "alice")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Greater(t, conn.timeout, time.Duration(0))
+	assert.LessOrEqual(t, conn.timeout, 50*time.Millisecond)
+}
+
+func TestLdapIdentityProviderEscapesFilter(t *testing.T) {
+	conn := &fakeLdapConn{
+		bindDn:   "cn=admin,dc=example,dc=com",
+		password: "secret",
+		entries: map[string]*ldap.Entry{
+			"(uid=*)": ldap.NewEntry("uid=alice,ou=people,dc=example,dc=com", map[string][]string{
+				"uidNumber": {"20001"},
+			}),
+		},
+	}
+	provider := newFakeLdapProvider(conn)
+
+	_, err := provider.LookupUid(context.Background(), "*")
+	assert.ErrorIs(t, err, ErrUserNotFound)
+}
+
+func TestUidMapIdentityProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "uid_map.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("alice: 20001\nbob: 20002\n"), 0600))
+
+	provider, err := NewUidMapIdentityProvider(path)
+	assert.NoError(t, err)
+
+	uid, err := provider.LookupUid(context.Background(), "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(20002), uid)
+
+	_, err = provider.LookupUid(context.Background(), "carol")
+	assert.ErrorIs(t, err, ErrUserNotFound)
+
+	_, err = NewUidMapIdentityProvider(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestChainIdentityProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "uid_map.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("alice: 20001\n"), 0600))
+	uidMap, err := NewUidMapIdentityProvider(path)
+	assert.NoError(t, err)
+
+	ldapProvider := newFakeLdapProvider(&fakeLdapConn{
+		bindDn:   "cn=admin,dc=example,dc=com",
+		password: "secret",
+		entries: map[string]*ldap.Entry{
+			"(uid=alice)": ldap.NewEntry("uid=alice,ou=people,dc=example,dc=com", map[string][]string{
+				"uidNumber": {"30001"},
+			}),
+			"(uid=dave)": ldap.NewEntry("uid=dave,ou=people,dc=example,dc=com", map[string][]string{
+				"uidNumber": {"30004"},
+			}),
+		},
+	})
+	chain := ChainIdentityProvider{uidMap, ldapProvider}
+
+	// 前面的身份源优先
+	uid, err := chain.LookupUid(context.Background(), "alice")
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(20001), uid)
+
+	// 只存在于目录中的用户
+	uid, err = chain.LookupUid(context.Background(), "dave")
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(30004), uid)
+
+	_, err = chain.LookupUid(context.Background(), "erin")
+	assert.ErrorIs(t, err, ErrUserNotFound)
+}
+
+func TestInitIdentityProviderUnknown(t *testing.T) {
+	defer func(identity IdentityProvider) { Identity = identity }(Identity)
+
+	assert.Error(t, InitIdentityProvider(IdentityConfig{Providers: []string{"kerberos"}}))
+	assert.NoError(t, InitIdentityProvider(IdentityConfig{}))
+	assert.Equal(t, ChainIdentityProvider{NssIdentityProvider{}}, Identity)
+}
@@ -0,0 +1,418 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// ResourceLimit 账户或用户的资源限制，字段为nil表示未设置
+// 鹤思的AccountInfo和UserInfo中没有这些字段，设置的值由适配器另行记录
+type ResourceLimit struct {
+	MaxJobs      *uint64 `json:"max_jobs,omitempty"`
+	MaxCpus      *uint64 `json:"max_cpus,omitempty"`
+	MaxTimeLimit *uint64 `json:"max_time_limit,omitempty"`
+}
+
+// LimitFields 资源限制对应的鹤思修改字段
+var LimitFields = []craneProtos.ModifyField{
+	craneProtos.ModifyField_MaxJobsPerUser,
+	craneProtos.ModifyField_MaxCpusPerUser,
+	craneProtos.ModifyField_MaxTimeLimitPerTask,
+}
+
+// ErrLimitExceedsCeiling 设置的资源限制超过qos或账户的上限
+var ErrLimitExceedsCeiling = errors.New("limit exceeds ceiling")
+
+var limitMu sync.Mutex
+
+func (l *ResourceLimit) field(field craneProtos.ModifyField) **uint64 {
+	switch field {
+	case craneProtos.ModifyField_MaxJobsPerUser:
+		return &l.MaxJobs
+	case craneProtos.ModifyField_MaxCpusPerUser:
+		return &l.MaxCpus
+	case craneProtos.ModifyField_MaxTimeLimitPerTask:
+		return &l.MaxTimeLimit
+	}
+	return nil
+}
+
+func (l *ResourceLimit) String() string {
+	var fields []string
+	for _, field := range LimitFields {
+		if value, ok := l.Get(field); ok {
+			fields = append(fields, fmt.Sprintf("%v=%v", field, value))
+		}
+	}
+	return "{" + strings.Join(fields, " ") + "}"
+}
+
+// Get 获取字段的值，未设置时返回false
+func (l *ResourceLimit) Get(field craneProtos.ModifyField) (uint64, bool) {
+	if l == nil {
+		return 0, false
+	}
+	value := l.field(field)
+	if value == nil || *value == nil {
+		return 0, false
+	}
+	return **value, true
+}
+
+func (l *ResourceLimit) set(field craneProtos.ModifyField, value uint64) {
+	if p := l.field(field); p != nil {
+		*p = &value
+	}
+}
+
+func (l *ResourceLimit) unset(field craneProtos.ModifyField) {
+	if p := l.field(field); p != nil {
+		*p = nil
+	}
+}
+
+// MinResourceLimit 逐字段取最小值，得到多层限制叠加后实际生效的限制
+func MinResourceLimit(limits ...*ResourceLimit) *ResourceLimit {
+	result := &ResourceLimit{}
+	for _, field := range LimitFields {
+		for _, limit := range limits {
+			value, ok := limit.Get(field)
+			if !ok {
+				continue
+			}
+			if current, exist := result.Get(field); !exist || value < current {
+				result.set(field, value)
+			}
+		}
+	}
+	return result
+}
+
+// GetQosInfoMap 获取系统中所有qos的详细信息
+func GetQosInfoMap(ctx context.Context) (map[string]*craneProtos.QosInfo, error) {
+	request := &craneProtos.QueryQosInfoRequest{
+		Uid: 0,
+	}
+	response, err := CraneCtld.QueryQosInfo(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if !response.GetOk() {
+		return nil, fmt.Errorf("query qos failed: %v", richErrorMessage(response.GetRichErrorList()))
+	}
+
+	qosMap := make(map[string]*craneProtos.QosInfo)
+	for _, qos := range response.GetQosList() {
+		qosMap[qos.GetName()] = qos
+	}
+	return qosMap, nil
+}
+
+// QosCeiling 计算一组qos的资源上限，作业可以使用其中任意一个qos，因此逐字段取最大值
+func QosCeiling(qosMap map[string]*craneProtos.QosInfo, qosList []string) *ResourceLimit {
+	ceiling := &ResourceLimit{}
+	for _, name := range qosList {
+		qos, ok := qosMap[name]
+		if !ok {
+			continue
+		}
+		for field, value := range map[craneProtos.ModifyField]uint64{
+			craneProtos.ModifyField_MaxJobsPerUser:      uint64(qos.GetMaxJobsPerUser()),
+			craneProtos.ModifyField_MaxCpusPerUser:      uint64(qos.GetMaxCpusPerUser()),
+			craneProtos.ModifyField_MaxTimeLimitPerTask: qos.GetMaxTimeLimitPerTask(),
+		} {
+			if current, exist := ceiling.Get(field); !exist || value > current {
+				ceiling.set(field, value)
+			}
+		}
+	}
+	return ceiling
+}
+
+func checkLimitCeiling(limit, ceiling *ResourceLimit, ceilingName string) error {
+	for _, field := range LimitFields {
+		value, ok := limit.Get(field)
+		if !ok {
+			continue
+		}
+		if ceilingValue, exist := ceiling.Get(field); exist && value > ceilingValue {
+			return fmt.Errorf("%w: %v %v exceeds %v ceiling %v", ErrLimitExceedsCeiling, field, value, ceilingName, ceilingValue)
+		}
+	}
+	return nil
+}
+
+func accountLimitKey(accountName string) string {
+	return accountName
+}
+
+func userLimitKey(userName, accountName string) string {
+	return accountName + "/" + userName
+}
+
+func getLimit(store *FileStore, key string) (*ResourceLimit, error) {
+	limit := &ResourceLimit{}
+	if store == nil {
+		return limit, nil
+	}
+	if _, err := store.Get(key, limit); err != nil {
+		return nil, err
+	}
+	return limit, nil
+}
+
+func saveLimit(store *FileStore, key string, limit *ResourceLimit) error {
+	if store == nil {
+		return nil
+	}
+	if *limit == (ResourceLimit{}) {
+		return store.Delete(key)
+	}
+	return store.Put(key, limit)
+}
+
+// GetAccountLimit 获取账户设置的资源限制
+func GetAccountLimit(accountName string) (*ResourceLimit, error) {
+	return getLimit(AccountLimitStore, accountLimitKey(accountName))
+}
+
+// GetUserLimit 获取用户在账户下设置的资源限制
+func GetUserLimit(userName, accountName string) (*ResourceLimit, error) {
+	return getLimit(UserLimitStore, userLimitKey(userName, accountName))
+}
+
+// GetEffectiveAccountLimit 获取账户实际生效的资源限制
+func GetEffectiveAccountLimit(account *craneProtos.AccountInfo, qosMap map[string]*craneProtos.QosInfo) (*ResourceLimit, error) {
+	limit, err := GetAccountLimit(account.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return MinResourceLimit(limit, QosCeiling(qosMap, account.GetAllowedQosList())), nil
+}
+
+// GetEffectiveUserLimit 获取用户在账户下实际生效的资源限制
+func GetEffectiveUserLimit(userName string, account *craneProtos.AccountInfo, qosMap map[string]*craneProtos.QosInfo) (*ResourceLimit, error) {
+	accountLimit, err := GetEffectiveAccountLimit(account, qosMap)
+	if err != nil {
+		return nil, err
+	}
+	userLimit, err := GetUserLimit(userName, account.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return MinResourceLimit(userLimit, accountLimit), nil
+}
+
+// SetAccountLimit 设置账户的资源限制，limit中未设置的字段保持不变
+func SetAccountLimit(ctx context.Context, accountName string, limit *ResourceLimit) error {
+	limitMu.Lock()
+	defer limitMu.Unlock()
+
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+	qosMap, err := GetQosInfoMap(ctx)
+	if err != nil {
+		return err
+	}
+	if err = checkLimitCeiling(limit, QosCeiling(qosMap, account.GetAllowedQosList()), "qos"); err != nil {
+		return err
+	}
+
+	current, err := GetAccountLimit(accountName)
+	if err != nil {
+		return err
+	}
+	// 降低账户的限制时，账户下用户已设置的限制不能超过新的限制
+	newLimit := MinResourceLimit(current, QosCeiling(qosMap, account.GetAllowedQosList()))
+	for _, field := range LimitFields {
+		if value, ok := limit.Get(field); ok {
+			newLimit.set(field, value)
+		}
+	}
+	for _, userName := range account.GetUsers() {
+		userLimit, err := GetUserLimit(userName, accountName)
+		if err != nil {
+			return err
+		}
+		if err = checkLimitCeiling(userLimit, newLimit, "account"); err != nil {
+			return fmt.Errorf("%w, lower the limit of user %v first", err, userName)
+		}
+	}
+
+	for _, field := range LimitFields {
+		value, ok := limit.Get(field)
+		if !ok {
+			continue
+		}
+		if err = modifyAccountField(ctx, accountName, field, craneProtos.OperationType_Overwrite, []string{strconv.FormatUint(value, 10)}, false); err != nil {
+			return err
+		}
+		current.set(field, value)
+	}
+
+	logrus.Infof("SetAccountLimit account %v limit %v success", accountName, current)
+	return saveLimit(AccountLimitStore, accountLimitKey(accountName), current)
+}
+
+// ClearAccountLimit 清除账户的资源限制，fields为空时清除所有字段
+// 鹤思中没有清除操作，清除时将限制改回qos的上限
+func ClearAccountLimit(ctx context.Context, accountName string, fields []craneProtos.ModifyField) error {
+	limitMu.Lock()
+	defer limitMu.Unlock()
+
+	if len(fields) == 0 {
+		fields = LimitFields
+	}
+
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+	qosMap, err := GetQosInfoMap(ctx)
+	if err != nil {
+		return err
+	}
+	ceiling := QosCeiling(qosMap, account.GetAllowedQosList())
+
+	current, err := GetAccountLimit(accountName)
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		if _, ok := current.Get(field); !ok {
+			continue
+		}
+		if value, ok := ceiling.Get(field); ok {
+			if err = modifyAccountField(ctx, accountName, field, craneProtos.OperationType_Overwrite, []string{strconv.FormatUint(value, 10)}, false); err != nil {
+				return err
+			}
+		}
+		current.unset(field)
+	}
+
+	logrus.Infof("ClearAccountLimit account %v fields %v success", accountName, fields)
+	return saveLimit(AccountLimitStore, accountLimitKey(accountName), current)
+}
+
+// SetUserLimit 设置用户在账户下的资源限制，不能超过账户的资源限制
+func SetUserLimit(ctx context.Context, userName, accountName string, limit *ResourceLimit) error {
+	limitMu.Lock()
+	defer limitMu.Unlock()
+
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+	if !Contains(account.GetUsers(), userName) {
+		return fmt.Errorf("%w: user %v not in account %v", ErrUserNotInAccount, userName, accountName)
+	}
+	qosMap, err := GetQosInfoMap(ctx)
+	if err != nil {
+		return err
+	}
+	accountLimit, err := GetEffectiveAccountLimit(account, qosMap)
+	if err != nil {
+		return err
+	}
+	if err = checkLimitCeiling(limit, accountLimit, "account"); err != nil {
+		return err
+	}
+
+	current, err := GetUserLimit(userName, accountName)
+	if err != nil {
+		return err
+	}
+	for _, field := range LimitFields {
+		value, ok := limit.Get(field)
+		if !ok {
+			continue
+		}
+		if err = modifyUserField(ctx, userName, accountName, "", field, craneProtos.OperationType_Overwrite, []string{strconv.FormatUint(value, 10)}); err != nil {
+			return err
+		}
+		current.set(field, value)
+	}
+
+	logrus.Infof("SetUserLimit user %v account %v limit %v success", userName, accountName, current)
+	return saveLimit(UserLimitStore, userLimitKey(userName, accountName), current)
+}
+
+// ClearUserLimit 清除用户在账户下的资源限制，清除时将限制改回账户生效的限制
+func ClearUserLimit(ctx context.Context, userName, accountName string, fields []craneProtos.ModifyField) error {
+	limitMu.Lock()
+	defer limitMu.Unlock()
+
+	if len(fields) == 0 {
+		fields = LimitFields
+	}
+
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+	qosMap, err := GetQosInfoMap(ctx)
+	if err != nil {
+		return err
+	}
+	accountLimit, err := GetEffectiveAccountLimit(account, qosMap)
+	if err != nil {
+		return err
+	}
+
+	current, err := GetUserLimit(userName, accountName)
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		if _, ok := current.Get(field); !ok {
+			continue
+		}
+		if value, ok := accountLimit.Get(field); ok {
+			if err = modifyUserField(ctx, userName, accountName, "", field, craneProtos.OperationType_Overwrite, []string{strconv.FormatUint(value, 10)}); err != nil {
+				return err
+			}
+		}
+		current.unset(field)
+	}
+
+	logrus.Infof("ClearUserLimit user %v account %v fields %v success", userName, accountName, fields)
+	return saveLimit(UserLimitStore, userLimitKey(userName, accountName), current)
+}
+
+// DeleteAccountLimits 删除账户及其下所有用户的资源限制记录，账户删除后调用
+func DeleteAccountLimits(accountName string) error {
+	if AccountLimitStore != nil {
+		if err := AccountLimitStore.Delete(accountLimitKey(accountName)); err != nil {
+			return err
+		}
+	}
+	if UserLimitStore != nil {
+		prefix := userLimitKey("", accountName)
+		for _, key := range UserLimitStore.Keys() {
+			if strings.HasPrefix(key, prefix) {
+				if err := UserLimitStore.Delete(key); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteUserLimit 删除用户在账户下的资源限制记录，用户移出账户后调用
+func DeleteUserLimit(userName, accountName string) error {
+	if UserLimitStore == nil {
+		return nil
+	}
+	return UserLimitStore.Delete(userLimitKey(userName, accountName))
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+func uint64Ptr(value uint64) *uint64 {
+	return &value
+}
+
+func TestQosCeilingAndMinResourceLimit(t *testing.T) {
+	qosMap := map[string]*craneProtos.QosInfo{
+		"normal": {Name: "normal", MaxJobsPerUser: 10, MaxCpusPerUser: 64, MaxTimeLimitPerTask: 3600},
+		"high":   {Name: "high", MaxJobsPerUser: 5, MaxCpusPerUser: 128, MaxTimeLimitPerTask: 7200},
+	}
+
+	// 作业可以使用任意一个qos，上限逐字段取最大值
+	ceiling := QosCeiling(qosMap, []string{"normal", "high", "missing"})
+	assert.Equal(t, &ResourceLimit{MaxJobs: uint64Ptr(10), MaxCpus: uint64Ptr(128), MaxTimeLimit: uint64Ptr(7200)}, ceiling)
+
+	// 多层限制叠加后逐字段取最小值，未设置的字段不参与
+	effective := MinResourceLimit(&ResourceLimit{MaxCpus: uint64Ptr(32)}, ceiling)
+	assert.Equal(t, &ResourceLimit{MaxJobs: uint64Ptr(10), MaxCpus: uint64Ptr(32), MaxTimeLimit: uint64Ptr(7200)}, effective)
+}
+
+func TestCheckLimitCeiling(t *testing.T) {
+	ceiling := &ResourceLimit{MaxJobs: uint64Ptr(10), MaxCpus: uint64Ptr(64)}
+
+	assert.NoError(t, checkLimitCeiling(&ResourceLimit{MaxJobs: uint64Ptr(10)}, ceiling, "account"))
+	// 上限中未设置的字段不限制
+	assert.NoError(t, checkLimitCeiling(&ResourceLimit{MaxTimeLimit: uint64Ptr(1 << 40)}, ceiling, "account"))
+
+	err := checkLimitCeiling(&ResourceLimit{MaxCpus: uint64Ptr(65)}, ceiling, "account")
+	assert.True(t, errors.Is(err, ErrLimitExceedsCeiling))
+}
@@ -0,0 +1,571 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// MigrationDocumentVersion 导出文档的格式版本，格式不兼容地变化时递增
+const MigrationDocumentVersion = 1
+
+// MigrationDocument 账户、用户及其关联关系的导出文档，用于在集群之间迁移或重建鹤思数据库
+type MigrationDocument struct {
+	Version    int                `json:"version" yaml:"version"`
+	ExportedAt time.Time          `json:"exported_at" yaml:"exported_at"`
+	Accounts   []MigrationAccount `json:"accounts" yaml:"accounts"`
+}
+
+type MigrationAccount struct {
+	Name              string   `json:"name" yaml:"name"`
+	Description       string   `json:"description,omitempty" yaml:"description,omitempty"`
+	ParentAccount     string   `json:"parent_account,omitempty" yaml:"parent_account,omitempty"`
+	AllowedPartitions []string `json:"allowed_partitions" yaml:"allowed_partitions"`
+	AllowedQosList    []string `json:"allowed_qos_list" yaml:"allowed_qos_list"`
+	DefaultQos        string   `json:"default_qos" yaml:"default_qos"`
+	Blocked           bool     `json:"blocked" yaml:"blocked"`
+	// 分区授予记录，为空时视为授予了AllowedPartitions且没有被封锁的分区
+	Grant *PartitionGrant `json:"grant,omitempty" yaml:"grant,omitempty"`
+	Users []MigrationUser `json:"users" yaml:"users"`
+}
+
+type MigrationUser struct {
+	Name                string                  `json:"name" yaml:"name"`
+	Uid                 uint32                  `json:"uid" yaml:"uid"`
+	AdminLevel          string                  `json:"admin_level" yaml:"admin_level"`
+	Blocked             bool                    `json:"blocked" yaml:"blocked"`
+	Coordinator         bool                    `json:"coordinator" yaml:"coordinator"`
+	AllowedPartitionQos []MigrationPartitionQos `json:"allowed_partition_qos" yaml:"allowed_partition_qos"`
+	// 用户在账户中被封锁的分区，这些分区不在AllowedPartitionQos中
+	BlockedPartitions []string `json:"blocked_partitions,omitempty" yaml:"blocked_partitions,omitempty"`
+}
+
+type MigrationPartitionQos struct {
+	Partition  string   `json:"partition" yaml:"partition"`
+	QosList    []string `json:"qos_list" yaml:"qos_list"`
+	DefaultQos string   `json:"default_qos" yaml:"default_qos"`
+}
+
+// MigrationAction 导入时需要执行的一步操作
+type MigrationAction struct {
+	Description string
+	apply       func(ctx context.Context) error
+}
+
+func (a *MigrationAction) Apply(ctx context.Context) error {
+	return a.apply(ctx)
+}
+
+// ExportAccountUsers 导出所有账户、用户及关联关系，账户按名称排序保证同一状态导出的文档相同
+func ExportAccountUsers(ctx context.Context) (*MigrationDocument, error) {
+	accounts, err := GetAllAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountUserInfoMap, err := GetAllAccountUserInfoConcurrently(ctx, accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	document := &MigrationDocument{Version: MigrationDocumentVersion, ExportedAt: time.Now()}
+	for _, account := range accounts {
+		grant, err := GetPartitionGrant(ctx, account)
+		if err != nil {
+			return nil, err
+		}
+		migrationAccount := MigrationAccount{
+			Name:              account.GetName(),
+			Description:       account.GetDescription(),
+			ParentAccount:     account.GetParentAccount(),
+			AllowedPartitions: account.GetAllowedPartitions(),
+			AllowedQosList:    account.GetAllowedQosList(),
+			DefaultQos:        account.GetDefaultQos(),
+			Blocked:           account.GetBlocked(),
+			Grant:             grant,
+		}
+		for _, user := range accountUserInfoMap[account] {
+			migrationUser := toMigrationUser(user, account.GetName())
+			if migrationUser.BlockedPartitions, err = GetUserBlockedPartitions(user.GetName(), account.GetName()); err != nil {
+				return nil, err
+			}
+			migrationAccount.Users = append(migrationAccount.Users, migrationUser)
+		}
+		sort.Slice(migrationAccount.Users, func(i, j int) bool {
+			return migrationAccount.Users[i].Name < migrationAccount.Users[j].Name
+		})
+		document.Accounts = append(document.Accounts, migrationAccount)
+	}
+	sort.Slice(document.Accounts, func(i, j int) bool {
+		return document.Accounts[i].Name < document.Accounts[j].Name
+	})
+	return document, nil
+}
+
+func toMigrationUser(user *craneProtos.UserInfo, accountName string) MigrationUser {
+	migrationUser := MigrationUser{
+		Name:        user.GetName(),
+		Uid:         user.GetUid(),
+		AdminLevel:  strings.ToLower(user.GetAdminLevel().String()),
+		Blocked:     user.GetBlocked(),
+		Coordinator: Contains(user.GetCoordinatorAccounts(), accountName),
+	}
+	for _, partitionQos := range user.GetAllowedPartitionQosList() {
+		migrationUser.AllowedPartitionQos = append(migrationUser.AllowedPartitionQos, MigrationPartitionQos{
+			Partition:  partitionQos.GetPartitionName(),
+			QosList:    partitionQos.GetQosList(),
+			DefaultQos: partitionQos.GetDefaultQos(),
+		})
+	}
+	return migrationUser
+}
+
+// MarshalMigrationDocument 按格式(json或yaml)序列化导出文档
+func MarshalMigrationDocument(document *MigrationDocument, format string) ([]byte, error) {
+	switch format {
+	case "json":
+		return json.MarshalIndent(document, "", "  ")
+	case "yaml", "yml":
+		return yaml.Marshal(document)
+	default:
+		return nil, fmt.Errorf("unknown format %v", format)
+	}
+}
+
+// LoadMigrationDocument 读取导出文档，格式根据文件扩展名判断
+func LoadMigrationDocument(path string) (*MigrationDocument, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	document := &MigrationDocument{}
+	if strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml") {
+		err = yaml.Unmarshal(content, document)
+	} else {
+		err = json.Unmarshal(content, document)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse %v failed: %v", path, err)
+	}
+	if document.Version != MigrationDocumentVersion {
+		return nil, fmt.Errorf("unsupported document version %v, expected %v", document.Version, MigrationDocumentVersion)
+	}
+	return document, nil
+}
+
+// migrationState 导入前鹤思中的账户、用户以及适配器中的分区授予和用户分区封锁记录，key与userOverrideKey相同
+type migrationState struct {
+	accounts              map[string]*craneProtos.AccountInfo
+	grants                map[string]*PartitionGrant
+	users                 map[string]*craneProtos.UserInfo
+	userBlockedPartitions map[string][]string
+}
+
+func loadMigrationState(ctx context.Context) (*migrationState, error) {
+	accounts, err := GetAllAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountUserInfoMap, err := GetAllAccountUserInfoConcurrently(ctx, accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &migrationState{
+		accounts:              make(map[string]*craneProtos.AccountInfo),
+		grants:                make(map[string]*PartitionGrant),
+		users:                 make(map[string]*craneProtos.UserInfo),
+		userBlockedPartitions: make(map[string][]string),
+	}
+	for _, account := range accounts {
+		state.accounts[account.GetName()] = account
+		if state.grants[account.GetName()], err = GetPartitionGrant(ctx, account); err != nil {
+			return nil, err
+		}
+		for _, user := range accountUserInfoMap[account] {
+			key := userOverrideKey(user.GetName(), account.GetName())
+			state.users[key] = user
+			if state.userBlockedPartitions[key], err = GetUserBlockedPartitions(user.GetName(), account.GetName()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return state, nil
+}
+
+// PlanImport 对比导出文档和鹤思中的当前状态，生成导入需要执行的操作
+// 导入只补齐文档中的内容，不删除文档中没有的账户、用户、分区和qos，因此重复导入不会产生新的操作
+func PlanImport(ctx context.Context, document *MigrationDocument) ([]*MigrationAction, error) {
+	state, err := loadMigrationState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return planImport(document, state)
+}
+
+func planImport(document *MigrationDocument, state *migrationState) ([]*MigrationAction, error) {
+	orderedAccounts, err := sortAccountsByParent(document.Accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []*MigrationAction
+	for _, account := range orderedAccounts {
+		actions = append(actions, planAccount(account, state.accounts[account.Name], state.grants[account.Name])...)
+		for _, user := range account.Users {
+			key := userOverrideKey(user.Name, account.Name)
+			actions = append(actions, planUser(user, account.Name, state.users[key], state.userBlockedPartitions[key])...)
+		}
+	}
+	return actions, nil
+}
+
+// migrationGrant 文档中账户的分区授予记录，旧文档中没有时由AllowedPartitions得到
+func migrationGrant(account MigrationAccount) *PartitionGrant {
+	if account.Grant != nil {
+		return account.Grant
+	}
+	return &PartitionGrant{Granted: account.AllowedPartitions}
+}
+
+// sortAccountsByParent 将父账户排在子账户之前，父账户需要先创建
+func sortAccountsByParent(accounts []MigrationAccount) ([]MigrationAccount, error) {
+	accountMap := make(map[string]MigrationAccount)
+	for _, account := range accounts {
+		accountMap[account.Name] = account
+	}
+
+	var ordered []MigrationAccount
+	visited := make(map[string]bool)
+	visiting := make(map[string]bool)
+	var visit func(name string) error
+	visit = func(name string) error {
+		if visited[name] {
+			return nil
+		}
+		if visiting[name] {
+			return fmt.Errorf("account %v has a cyclic parent", name)
+		}
+		account, ok := accountMap[name]
+		if !ok {
+			// 文档中没有的父账户需要已存在于鹤思中
+			return nil
+		}
+		visiting[name] = true
+		if account.ParentAccount != "" {
+			if err := visit(account.ParentAccount); err != nil {
+				return err
+			}
+		}
+		visiting[name] = false
+		visited[name] = true
+		ordered = append(ordered, account)
+		return nil
+	}
+
+	for _, account := range accounts {
+		if err := visit(account.Name); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+func planAccount(account MigrationAccount, current *craneProtos.AccountInfo, currentGrant *PartitionGrant) []*MigrationAction {
+	var actions []*MigrationAction
+	grant := migrationGrant(account)
+	if current == nil {
+		actions = append(actions, &MigrationAction{
+			Description: fmt.Sprintf("+ create account %v (parent: %q, partitions: %v, blocked partitions: %v, qos: %v, default qos: %v)", account.Name, account.ParentAccount, grant.Granted, grant.Blocked, account.AllowedQosList, account.DefaultQos),
+			apply: func(ctx context.Context) error {
+				return importAccount(ctx, account)
+			},
+		})
+		if account.Blocked {
+			actions = append(actions, &MigrationAction{
+				Description: fmt.Sprintf("~ block account %v", account.Name),
+				apply:       func(ctx context.Context) error { return BlockAccount(ctx, account.Name) },
+			})
+		}
+		return actions
+	}
+	if currentGrant == nil {
+		currentGrant = &PartitionGrant{Granted: current.GetAllowedPartitions()}
+	}
+
+	// 先授予分区，再按文档封锁或解封其中的分区
+	if addPartitions := SliceSubtract(grant.Granted, currentGrant.Granted); len(addPartitions) != 0 {
+		actions = append(actions, &MigrationAction{
+			Description: fmt.Sprintf("~ grant partitions %v to account %v", addPartitions, account.Name),
+			apply: func(ctx context.Context) error {
+				return GrantAccountPartitions(ctx, account.Name, addPartitions)
+			},
+		})
+	}
+	if blockPartitions := SliceSubtract(grant.Blocked, currentGrant.Blocked); len(blockPartitions) != 0 {
+		actions = append(actions, &MigrationAction{
+			Description: fmt.Sprintf("~ block account %v in partitions %v", account.Name, blockPartitions),
+			apply: func(ctx context.Context) error {
+				return BlockAccountWithPartition(ctx, account.Name, blockPartitions)
+			},
+		})
+	}
+	if unblockPartitions := SliceSubtract(SliceIntersect(currentGrant.Blocked, grant.Granted), grant.Blocked); len(unblockPartitions) != 0 {
+		actions = append(actions, &MigrationAction{
+			Description: fmt.Sprintf("~ unblock account %v in partitions %v", account.Name, unblockPartitions),
+			apply: func(ctx context.Context) error {
+				return UnblockAccountWithPartition(ctx, account.Name, unblockPartitions)
+			},
+		})
+	}
+	if addQos := SliceSubtract(account.AllowedQosList, current.GetAllowedQosList()); len(addQos) != 0 {
+		actions = append(actions, &MigrationAction{
+			Description: fmt.Sprintf("~ add qos %v to account %v", addQos, account.Name),
+			apply: func(ctx context.Context) error {
+				return modifyAccountField(ctx, account.Name, craneProtos.ModifyField_Qos, craneProtos.OperationType_Add, addQos, false)
+			},
+		})
+	}
+	if account.DefaultQos != "" && account.DefaultQos != current.GetDefaultQos() {
+		actions = append(actions, &MigrationAction{
+			Description: fmt.Sprintf("~ set default qos of account %v: %v -> %v", account.Name, current.GetDefaultQos(), account.DefaultQos),
+			apply: func(ctx context.Context) error {
+				return modifyAccountField(ctx, account.Name, craneProtos.ModifyField_DefaultQos, craneProtos.OperationType_Overwrite, []string{account.DefaultQos}, false)
+			},
+		})
+	}
+	if account.Blocked != current.GetBlocked() {
+		if account.Blocked {
+			actions = append(actions, &MigrationAction{
+				Description: fmt.Sprintf("~ block account %v", account.Name),
+				apply:       func(ctx context.Context) error { return BlockAccount(ctx, account.Name) },
+			})
+		} else {
+			actions = append(actions, &MigrationAction{
+				Description: fmt.Sprintf("~ unblock account %v", account.Name),
+				apply:       func(ctx context.Context) error { return UnblockAccount(ctx, account.Name) },
+			})
+		}
+	}
+	return actions
+}
+
+func importAccount(ctx context.Context, account MigrationAccount) error {
+	description := account.Description
+	if description == "" {
+		description = defaultAccountDescription
+	}
+	request := &craneProtos.AddAccountRequest{
+		Uid: uint32(os.Getuid()),
+		Account: &craneProtos.AccountInfo{
+			Name:              account.Name,
+			Description:       description,
+			ParentAccount:     account.ParentAccount,
+			AllowedPartitions: account.AllowedPartitions,
+			DefaultQos:        account.DefaultQos,
+			AllowedQosList:    account.AllowedQosList,
+		},
+	}
+	response, err := CraneCtld.AddAccount(ctx, request)
+	if err != nil {
+		return err
+	}
+	if !response.GetOk() {
+		return fmt.Errorf("create account %v failed, code: %v", account.Name, response.GetCode())
+	}
+	return savePartitionGrant(account.Name, migrationGrant(account))
+}
+
+func planUser(user MigrationUser, accountName string, current *craneProtos.UserInfo, currentBlockedPartitions []string) []*MigrationAction {
+	var actions []*MigrationAction
+	if current == nil {
+		actions = append(actions, &MigrationAction{
+			Description: fmt.Sprintf("+ add user %v to account %v (coordinator: %v, partitions: %v, blocked partitions: %v)", user.Name, accountName, user.Coordinator, migrationPartitions(user.AllowedPartitionQos), user.BlockedPartitions),
+			apply: func(ctx context.Context) error {
+				return importUser(ctx, user, accountName)
+			},
+		})
+		if user.Blocked {
+			actions = append(actions, &MigrationAction{
+				Description: fmt.Sprintf("~ block user %v in account %v", user.Name, accountName),
+				apply:       func(ctx context.Context) error { return BlockUserInAccount(ctx, user.Name, accountName) },
+			})
+		}
+		return actions
+	}
+
+	currentPartitionQos := make(map[string]*craneProtos.UserInfo_AllowedPartitionQos)
+	var currentPartitions []string
+	for _, partitionQos := range current.GetAllowedPartitionQosList() {
+		currentPartitionQos[partitionQos.GetPartitionName()] = partitionQos
+		currentPartitions = append(currentPartitions, partitionQos.GetPartitionName())
+	}
+	if addPartitions := SliceSubtract(migrationPartitions(user.AllowedPartitionQos), currentPartitions); len(addPartitions) != 0 {
+		actions = append(actions, &MigrationAction{
+			Description: fmt.Sprintf("~ add partitions %v to user %v in account %v", addPartitions, user.Name, accountName),
+			apply: func(ctx context.Context) error {
+				return modifyUserField(ctx, user.Name, accountName, "", craneProtos.ModifyField_Partition, craneProtos.OperationType_Add, addPartitions)
+			},
+		})
+	}
+	for _, partitionQos := range user.AllowedPartitionQos {
+		partition := partitionQos.Partition
+		var currentQos []string
+		currentDefaultQos := ""
+		if userPartitionQos, ok := currentPartitionQos[partition]; ok {
+			currentQos = userPartitionQos.GetQosList()
+			currentDefaultQos = userPartitionQos.GetDefaultQos()
+		}
+		if addQos := SliceSubtract(partitionQos.QosList, currentQos); len(addQos) != 0 {
+			actions = append(actions, &MigrationAction{
+				Description: fmt.Sprintf("~ add qos %v to user %v in account %v partition %v", addQos, user.Name, accountName, partition),
+				apply: func(ctx context.Context) error {
+					return modifyUserField(ctx, user.Name, accountName, partition, craneProtos.ModifyField_Qos, craneProtos.OperationType_Add, addQos)
+				},
+			})
+		}
+		if partitionQos.DefaultQos != "" && partitionQos.DefaultQos != currentDefaultQos {
+			defaultQos := partitionQos.DefaultQos
+			actions = append(actions, &MigrationAction{
+				Description: fmt.Sprintf("~ set default qos of user %v in account %v partition %v: %q -> %v", user.Name, accountName, partition, currentDefaultQos, defaultQos),
+				apply: func(ctx context.Context) error {
+					return modifyUserField(ctx, user.Name, accountName, partition, craneProtos.ModifyField_DefaultQos, craneProtos.OperationType_Overwrite, []string{defaultQos})
+				},
+			})
+		}
+	}
+
+	if user.AdminLevel != "" && user.AdminLevel != strings.ToLower(current.GetAdminLevel().String()) {
+		actions = append(actions, &MigrationAction{
+			Description: fmt.Sprintf("~ set admin level of user %v: %v -> %v", user.Name, strings.ToLower(current.GetAdminLevel().String()), user.AdminLevel),
+			apply: func(ctx context.Context) error {
+				return modifyUserField(ctx, user.Name, "", "", craneProtos.ModifyField_AdminLevel, craneProtos.OperationType_Overwrite, []string{user.AdminLevel})
+			},
+		})
+	}
+	if user.Coordinator != Contains(current.GetCoordinatorAccounts(), accountName) {
+		// 鹤思不支持修改已有用户的协调者身份，只提示需要手动处理
+		actions = append(actions, &MigrationAction{
+			Description: fmt.Sprintf("! coordinator of existing user %v in account %v should be %v, change it manually", user.Name, accountName, user.Coordinator),
+			apply: func(ctx context.Context) error {
+				logrus.Warnf("skip changing coordinator of user %v in account %v: %v", user.Name, accountName, ErrCoordinatorChangeUnsupported)
+				return nil
+			},
+		})
+	}
+	if blockPartitions := SliceSubtract(user.BlockedPartitions, currentBlockedPartitions); len(blockPartitions) != 0 {
+		actions = append(actions, &MigrationAction{
+			Description: fmt.Sprintf("~ block user %v in account %v partitions %v", user.Name, accountName, blockPartitions),
+			apply: func(ctx context.Context) error {
+				return BlockUserInAccountWithPartition(ctx, user.Name, accountName, blockPartitions)
+			},
+		})
+	}
+	if unblockPartitions := SliceSubtract(currentBlockedPartitions, user.BlockedPartitions); len(unblockPartitions) != 0 {
+		actions = append(actions, &MigrationAction{
+			Description: fmt.Sprintf("~ unblock user %v in account %v partitions %v", user.Name, accountName, unblockPartitions),
+			apply: func(ctx context.Context) error {
+				return UnblockUserInAccountWithPartition(ctx, user.Name, accountName, unblockPartitions)
+			},
+		})
+	}
+	if user.Blocked != current.GetBlocked() {
+		if user.Blocked {
+			actions = append(actions, &MigrationAction{
+				Description: fmt.Sprintf("~ block user %v in account %v", user.Name, accountName),
+				apply:       func(ctx context.Context) error { return BlockUserInAccount(ctx, user.Name, accountName) },
+			})
+		} else {
+			actions = append(actions, &MigrationAction{
+				Description: fmt.Sprintf("~ unblock user %v in account %v", user.Name, accountName),
+				apply:       func(ctx context.Context) error { return UnblockUserInAccount(ctx, user.Name, accountName) },
+			})
+		}
+	}
+	return actions
+}
+
+func importUser(ctx context.Context, user MigrationUser, accountName string) error {
+	uid := user.Uid
+	if uid == 0 {
+		localUid, err := GetUidByUserName(ctx, user.Name)
+		if err != nil {
+			return err
+		}
+		uid = uint32(localUid)
+	}
+
+	adminLevel, ok := craneProtos.UserInfo_AdminLevel_value[adminLevelEnumName(user.AdminLevel)]
+	if user.AdminLevel != "" && !ok {
+		return fmt.Errorf("unknown admin level %v of user %v", user.AdminLevel, user.Name)
+	}
+
+	userInfo := &craneProtos.UserInfo{
+		Uid:        uid,
+		Name:       user.Name,
+		Account:    accountName,
+		AdminLevel: craneProtos.UserInfo_AdminLevel(adminLevel),
+	}
+	for _, partitionQos := range user.AllowedPartitionQos {
+		userInfo.AllowedPartitionQosList = append(userInfo.AllowedPartitionQosList, &craneProtos.UserInfo_AllowedPartitionQos{
+			PartitionName: partitionQos.Partition,
+			QosList:       partitionQos.QosList,
+			DefaultQos:    partitionQos.DefaultQos,
+		})
+	}
+	if user.Coordinator {
+		userInfo.CoordinatorAccounts = []string{accountName}
+	}
+	if err := addUser(ctx, userInfo); err != nil {
+		return err
+	}
+	// 被封锁的分区已不在AllowedPartitionQos中，只需恢复封锁记录
+	return saveUserBlockedPartitions(user.Name, accountName, user.BlockedPartitions)
+}
+
+// adminLevelEnumName 将小写的管理级别转为鹤思枚举的名称，如 operator -> Operator
+func adminLevelEnumName(level string) string {
+	if level == "" {
+		return ""
+	}
+	return strings.ToUpper(level[:1]) + level[1:]
+}
+
+func migrationPartitions(partitionQosList []MigrationPartitionQos) []string {
+	var partitions []string
+	for _, partitionQos := range partitionQosList {
+		partitions = append(partitions, partitionQos.Partition)
+	}
+	return partitions
+}
+
+// ApplyImport 依次执行导入操作，每秒最多执行actionsPerSecond个操作，避免短时间内大量请求压垮CraneCtld
+// 一个操作可能调用CraneCtld多次，限制的是操作数而不是调用数
+// 遇到错误时停止，已执行的操作不回滚，修复问题后重新导入即可从中断处继续
+func ApplyImport(ctx context.Context, actions []*MigrationAction, actionsPerSecond int, onApplied func(action *MigrationAction)) error {
+	if actionsPerSecond <= 0 {
+		actionsPerSecond = 1
+	}
+	ticker := time.NewTicker(time.Second / time.Duration(actionsPerSecond))
+	defer ticker.Stop()
+
+	for _, action := range actions {
+		<-ticker.C
+		if err := action.Apply(ctx); err != nil {
+			return fmt.Errorf("%v: %v", action.Description, err)
+		}
+		if onApplied != nil {
+			onApplied(action)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+func emptyMigrationState() *migrationState {
+	return &migrationState{
+		accounts:              map[string]*craneProtos.AccountInfo{},
+		grants:                map[string]*PartitionGrant{},
+		users:                 map[string]*craneProtos.UserInfo{},
+		userBlockedPartitions: map[string][]string{},
+	}
+}
+
+func TestPlanImport(t *testing.T) {
+	existing := func() *migrationState {
+		state := emptyMigrationState()
+		state.accounts["a"] = &craneProtos.AccountInfo{Name: "a", AllowedPartitions: []string{"p1"}, AllowedQosList: []string{"q1"}, DefaultQos: "q1"}
+		state.grants["a"] = &PartitionGrant{Granted: []string{"p1"}}
+		state.users["a/u1"] = &craneProtos.UserInfo{
+			Name:                    "u1",
+			Account:                 "a",
+			AllowedPartitionQosList: []*craneProtos.UserInfo_AllowedPartitionQos{{PartitionName: "p1", QosList: []string{"q1"}, DefaultQos: "q1"}},
+		}
+		return state
+	}
+	account := func(grant *PartitionGrant, blockedPartitions []string) MigrationAccount {
+		return MigrationAccount{
+			Name:              "a",
+			AllowedPartitions: []string{"p1"},
+			AllowedQosList:    []string{"q1"},
+			DefaultQos:        "q1",
+			Grant:             grant,
+			Users: []MigrationUser{{
+				Name:                "u1",
+				AdminLevel:          "none",
+				AllowedPartitionQos: []MigrationPartitionQos{{Partition: "p1", QosList: []string{"q1"}, DefaultQos: "q1"}},
+				BlockedPartitions:   blockedPartitions,
+			}},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		accounts []MigrationAccount
+		state    func() *migrationState
+		expected []string
+	}{
+		{
+			name:     "up to date",
+			accounts: []MigrationAccount{account(&PartitionGrant{Granted: []string{"p1"}}, nil)},
+			state:    existing,
+		},
+		{
+			// 旧文档没有授予记录，按AllowedPartitions对比
+			name:     "document without grant",
+			accounts: []MigrationAccount{account(nil, nil)},
+			state:    existing,
+		},
+		{
+			name:     "grant and block partition",
+			accounts: []MigrationAccount{account(&PartitionGrant{Granted: []string{"p1", "p2"}, Blocked: []string{"p2"}}, nil)},
+			state:    existing,
+			expected: []string{
+				"~ grant partitions [p2] to account a",
+				"~ block account a in partitions [p2]",
+			},
+		},
+		{
+			name:     "unblock partition",
+			accounts: []MigrationAccount{account(&PartitionGrant{Granted: []string{"p1", "p2"}}, nil)},
+			state: func() *migrationState {
+				state := existing()
+				state.grants["a"] = &PartitionGrant{Granted: []string{"p1", "p2"}, Blocked: []string{"p2"}}
+				return state
+			},
+			expected: []string{"~ unblock account a in partitions [p2]"},
+		},
+		{
+			// 文档中未授予的分区不收回，也不解封
+			name:     "keep partitions missing from document",
+			accounts: []MigrationAccount{account(&PartitionGrant{Granted: []string{"p1"}}, nil)},
+			state: func() *migrationState {
+				state := existing()
+				state.grants["a"] = &PartitionGrant{Granted: []string{"p1", "p2"}, Blocked: []string{"p2"}}
+				return state
+			},
+		},
+		{
+			name:     "block and unblock user partitions",
+			accounts: []MigrationAccount{account(&PartitionGrant{Granted: []string{"p1"}}, []string{"p2"})},
+			state: func() *migrationState {
+				state := existing()
+				state.userBlockedPartitions["a/u1"] = []string{"p3"}
+				return state
+			},
+			expected: []string{
+				"~ block user u1 in account a partitions [p2]",
+				"~ unblock user u1 in account a partitions [p3]",
+			},
+		},
+		{
+			// 父账户排在子账户之前
+			name: "create accounts",
+			accounts: []MigrationAccount{
+				{Name: "child", ParentAccount: "parent", AllowedPartitions: []string{"p1"}, Grant: &PartitionGrant{Granted: []string{"p1", "p2"}, Blocked: []string{"p2"}}, Blocked: true},
+				{Name: "parent", AllowedPartitions: []string{"p1"}},
+			},
+			state: emptyMigrationState,
+			expected: []string{
+				`+ create account parent (parent: "", partitions: [p1], blocked partitions: [], qos: [], default qos: )`,
+				`+ create account child (parent: "parent", partitions: [p1 p2], blocked partitions: [p2], qos: [], default qos: )`,
+				"~ block account child",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actions, err := planImport(&MigrationDocument{Version: MigrationDocumentVersion, Accounts: test.accounts}, test.state())
+			require.NoError(t, err)
+			var descriptions []string
+			for _, action := range actions {
+				descriptions = append(descriptions, action.Description)
+			}
+			assert.Equal(t, test.expected, descriptions)
+		})
+	}
+}
+
+func TestSortAccountsByParentRejectsCycle(t *testing.T) {
+	_, err := sortAccountsByParent([]MigrationAccount{
+		{Name: "a", ParentAccount: "b"},
+		{Name: "b", ParentAccount: "a"},
+	})
+	assert.Error(t, err)
+}
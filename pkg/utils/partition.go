@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+const defaultPartitionRefreshInterval = 60 * time.Second
+
+// partitionCache 从CraneCtld查询到的分区列表，超过刷新间隔后重新查询
+// 控制节点上新增的分区不需要同步鹤思配置文件、重启适配器即可使用
+type partitionCache struct {
+	mu              sync.Mutex
+	partitions      []string
+	updatedAt       time.Time
+	refreshInterval time.Duration
+	// 为true时只使用鹤思配置文件中列出的分区
+	allowList bool
+	// 每次重新加载配置时加一，查询期间配置被重新加载时丢弃查询结果
+	generation uint64
+}
+
+var partitionDiscovery = &partitionCache{refreshInterval: defaultPartitionRefreshInterval}
+
+// InitPartitionDiscovery 根据配置设置分区列表的刷新间隔以及鹤思配置文件中分区的用途
+func InitPartitionDiscovery(config PartitionConfig) {
+	partitionDiscovery.mu.Lock()
+	defer partitionDiscovery.mu.Unlock()
+
+	partitionDiscovery.refreshInterval = defaultPartitionRefreshInterval
+	if config.RefreshInterval > 0 {
+		partitionDiscovery.refreshInterval = time.Duration(config.RefreshInterval) * time.Second
+	}
+	partitionDiscovery.allowList = config.AllowList
+	partitionDiscovery.updatedAt = time.Time{}
+	partitionDiscovery.generation++
+	logrus.Infof("partition discovery refresh interval: %v, allow list: %v", partitionDiscovery.refreshInterval, partitionDiscovery.allowList)
+}
+
+// GetAllPartitions 获取集群的所有分区
+// 分区从CraneCtld实时查询并缓存，鹤思配置文件中的分区用于排序，配置了allow-list时还用于过滤
+// 查询失败时使用上次的结果，从未查询成功时使用配置文件中的分区
+// 查询CraneCtld时不持有锁，缓存过期时并发的调用各自查询，不会阻塞在同一个慢查询上
+func GetAllPartitions(ctx context.Context) []string {
+	partitionDiscovery.mu.Lock()
+	// 返回副本，避免调用方修改缓存
+	if partitionDiscovery.partitions != nil && time.Since(partitionDiscovery.updatedAt) < partitionDiscovery.refreshInterval {
+		partitions := append([]string{}, partitionDiscovery.partitions...)
+		partitionDiscovery.mu.Unlock()
+		return partitions
+	}
+	generation := partitionDiscovery.generation
+	partitionDiscovery.mu.Unlock()
+
+	discovered, err := queryPartitionNames(ctx)
+
+	partitionDiscovery.mu.Lock()
+	defer partitionDiscovery.mu.Unlock()
+	if err != nil {
+		logrus.Warnf("GetAllPartitions query partitions from CraneCtld failed: %v", err)
+		if partitionDiscovery.partitions != nil {
+			return append([]string{}, partitionDiscovery.partitions...)
+		}
+		return configPartitionNames()
+	}
+
+	partitions := orderPartitions(discovered, configPartitionNames(), partitionDiscovery.allowList)
+	if generation == partitionDiscovery.generation {
+		partitionDiscovery.partitions = partitions
+		partitionDiscovery.updatedAt = time.Now()
+	}
+	return append([]string{}, partitions...)
+}
+
+func queryPartitionNames(ctx context.Context) ([]string, error) {
+	response, err := CraneCtld.QueryPartitionInfo(ctx, &craneProtos.QueryPartitionInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, partition := range response.GetPartitionInfoList() {
+		names = append(names, partition.GetName())
+	}
+	return names, nil
+}
+
+func configPartitionNames() []string {
+	if CConfig == nil || CConfig.Partitions == nil {
+		return nil
+	}
+
+	var names []string
+	for _, partition := range CConfig.Partitions {
+		names = append(names, partition.Name)
+	}
+	return names
+}
+
+// orderPartitions 配置文件中的分区按配置顺序排在前面，其余分区按名称排序
+func orderPartitions(discovered, configured []string, allowList bool) []string {
+	ordered := []string{}
+	for _, name := range configured {
+		if Contains(discovered, name) {
+			ordered = append(ordered, name)
+		}
+	}
+	if allowList {
+		return ordered
+	}
+
+	others := SliceSubtract(discovered, configured)
+	sort.Strings(others)
+	return append(ordered, others...)
+}
@@ -0,0 +1,195 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+const bytesPerMb = 1024 * 1024
+
+var nodeFeatures atomic.Pointer[map[string][]string]
+
+// SetNodeFeatures 设置节点的特性，key为节点名的通配符(如 gpu*)，鹤思中没有节点特性，由适配器配置，可以在运行时重新加载
+func SetNodeFeatures(features map[string][]string) {
+	nodeFeatures.Store(&features)
+}
+
+// getNodeFeatures 返回节点名匹配的所有通配符对应的特性，去重并排序
+func getNodeFeatures(hostname string) []string {
+	features := nodeFeatures.Load()
+	if features == nil {
+		return nil
+	}
+	var result []string
+	for pattern, patternFeatures := range *features {
+		if matched, _ := path.Match(pattern, hostname); !matched {
+			continue
+		}
+		for _, feature := range patternFeatures {
+			if !Contains(result, feature) {
+				result = append(result, feature)
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// GetPartitionShapes 获取分区中节点的规格，以及由此得到的每核内存、单节点上限等信息
+// qosList为可用的qos，用于计算最大运行时间；defaultQos不为空时用于计算默认运行时间
+func GetPartitionShapes(ctx context.Context, partitionNames, qosList []string, defaultQos string) ([]*adapterProtos.PartitionShape, error) {
+	response, err := CraneCtld.QueryCranedInfo(ctx, &craneProtos.QueryCranedInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+	qosMap, err := GetQosInfoMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 一次查询所有分区，不逐个分区查询
+	partitionResponse, err := CraneCtld.QueryPartitionInfo(ctx, &craneProtos.QueryPartitionInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+	partitionMap := make(map[string]*craneProtos.PartitionInfo)
+	for _, partition := range partitionResponse.GetPartitionInfoList() {
+		partitionMap[partition.GetName()] = partition
+	}
+
+	var shapes []*adapterProtos.PartitionShape
+	for _, partitionName := range partitionNames {
+		partition, ok := partitionMap[partitionName]
+		if !ok {
+			return nil, fmt.Errorf("%w: %v", ErrPartitionNotFound, partitionName)
+		}
+
+		var nodes []*craneProtos.CranedInfo
+		for _, node := range response.GetCranedInfoList() {
+			// 宕机节点的规格不代表当前可用的资源
+			if Contains(node.GetPartitionNames(), partitionName) && node.GetResourceState() != craneProtos.CranedResourceState_CRANE_DOWN {
+				nodes = append(nodes, node)
+			}
+		}
+
+		shape := buildPartitionShape(partition, nodes)
+		setPartitionTimeLimits(shape, qosMap, qosList, defaultQos)
+		shapes = append(shapes, shape)
+	}
+	return shapes, nil
+}
+
+func buildPartitionShape(partition *craneProtos.PartitionInfo, nodes []*craneProtos.CranedInfo) *adapterProtos.PartitionShape {
+	shape := &adapterProtos.PartitionShape{Partition: partition.GetName()}
+
+	nodeShapes := make(map[string]*adapterProtos.NodeShape)
+	gpuTypes := make(map[string]struct{})
+	features := make(map[string]struct{})
+	var minMemPerCoreMb uint64
+	for _, node := range nodes {
+		nodeShape := toNodeShape(node)
+		key := nodeShapeKey(nodeShape)
+		if existing, ok := nodeShapes[key]; ok {
+			existing.NodeCount++
+		} else {
+			nodeShape.NodeCount = 1
+			nodeShapes[key] = nodeShape
+		}
+
+		if nodeShape.GetCores() > shape.MaxCoresPerNode {
+			shape.MaxCoresPerNode = nodeShape.GetCores()
+		}
+		if nodeShape.GetMemMb() > shape.MaxMemMbPerNode {
+			shape.MaxMemMbPerNode = nodeShape.GetMemMb()
+		}
+		if nodeShape.GetCores() != 0 {
+			memPerCoreMb := nodeShape.GetMemMb() / uint64(nodeShape.GetCores())
+			if minMemPerCoreMb == 0 || memPerCoreMb < minMemPerCoreMb {
+				minMemPerCoreMb = memPerCoreMb
+			}
+		}
+		for _, device := range nodeShape.GetDevices() {
+			gpuTypes[device.GetType()] = struct{}{}
+		}
+		for _, feature := range nodeShape.GetFeatures() {
+			features[feature] = struct{}{}
+		}
+	}
+
+	for _, nodeShape := range nodeShapes {
+		shape.NodeShapes = append(shape.NodeShapes, nodeShape)
+	}
+	sort.Slice(shape.NodeShapes, func(i, j int) bool {
+		return nodeShapeKey(shape.NodeShapes[i]) < nodeShapeKey(shape.NodeShapes[j])
+	})
+	for gpuType := range gpuTypes {
+		shape.GpuTypes = append(shape.GpuTypes, gpuType)
+	}
+	sort.Strings(shape.GpuTypes)
+	for feature := range features {
+		shape.Features = append(shape.Features, feature)
+	}
+	sort.Strings(shape.Features)
+
+	// 分区配置了每核内存时以配置为准，否则按内存最少的节点计算，保证在任意节点上都能满足
+	shape.DefaultMemPerCoreMb = partition.GetDefaultMemPerCpu() / bytesPerMb
+	if shape.DefaultMemPerCoreMb == 0 {
+		shape.DefaultMemPerCoreMb = minMemPerCoreMb
+	}
+	shape.MaxMemPerCoreMb = partition.GetMaxMemPerCpu() / bytesPerMb
+	if shape.MaxMemPerCoreMb == 0 {
+		shape.MaxMemPerCoreMb = shape.MaxMemMbPerNode
+	}
+	return shape
+}
+
+func toNodeShape(node *craneProtos.CranedInfo) *adapterProtos.NodeShape {
+	allocatable := node.GetResTotal().GetAllocatableResInNode()
+	nodeShape := &adapterProtos.NodeShape{
+		Cores:    uint32(allocatable.GetCpuCoreLimit()),
+		MemMb:    allocatable.GetMemoryLimitBytes() / bytesPerMb,
+		Features: getNodeFeatures(node.GetHostname()),
+	}
+	// name_type_map:{key:"npu" value:{type_slots_map:{key:"910B3" value:{slots:["/dev/davinci0",...]}}}}
+	for name, typeSlotsMap := range node.GetResTotal().GetDedicatedResInNode().GetNameTypeMap() {
+		for deviceType, slots := range typeSlotsMap.GetTypeSlotsMap() {
+			nodeShape.Devices = append(nodeShape.Devices, &adapterProtos.DeviceCount{
+				Name:  name,
+				Type:  deviceType,
+				Count: uint32(len(slots.GetSlots())),
+			})
+		}
+	}
+	sort.Slice(nodeShape.Devices, func(i, j int) bool {
+		if nodeShape.Devices[i].GetName() != nodeShape.Devices[j].GetName() {
+			return nodeShape.Devices[i].GetName() < nodeShape.Devices[j].GetName()
+		}
+		return nodeShape.Devices[i].GetType() < nodeShape.Devices[j].GetType()
+	})
+	return nodeShape
+}
+
+func nodeShapeKey(nodeShape *adapterProtos.NodeShape) string {
+	var devices []string
+	for _, device := range nodeShape.GetDevices() {
+		devices = append(devices, fmt.Sprintf("%v:%v:%v", device.GetName(), device.GetType(), device.GetCount()))
+	}
+	return fmt.Sprintf("%08d/%012d/%v/%v", nodeShape.GetCores(), nodeShape.GetMemMb(), strings.Join(devices, ","), strings.Join(nodeShape.GetFeatures(), ","))
+}
+
+func setPartitionTimeLimits(shape *adapterProtos.PartitionShape, qosMap map[string]*craneProtos.QosInfo, qosList []string, defaultQos string) {
+	if maxTimeLimit, ok := QosCeiling(qosMap, qosList).Get(craneProtos.ModifyField_MaxTimeLimitPerTask); ok && maxTimeLimit != 0 {
+		shape.MaxTimeLimitSeconds = &maxTimeLimit
+	}
+	// 作业未指定运行时间时使用默认qos的最大运行时间
+	if qos, ok := qosMap[defaultQos]; ok && qos.GetMaxTimeLimitPerTask() != 0 {
+		defaultTimeLimit := qos.GetMaxTimeLimitPerTask()
+		shape.DefaultTimeLimitSeconds = &defaultTimeLimit
+	}
+}
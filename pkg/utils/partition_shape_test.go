@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+func cranedInfo(hostname string, cores float64, memMb uint64, partitions ...string) *craneProtos.CranedInfo {
+	return &craneProtos.CranedInfo{
+		Hostname:       hostname,
+		PartitionNames: partitions,
+		ResTotal: &craneProtos.ResourceInNode{
+			AllocatableResInNode: &craneProtos.AllocatableResource{CpuCoreLimit: cores, MemoryLimitBytes: memMb * bytesPerMb},
+		},
+	}
+}
+
+func TestGetPartitionShapes(t *testing.T) {
+	stub := newFakeCraneCtld()
+	stub.partitions = []*craneProtos.PartitionInfo{{Name: "cpu"}, {Name: "gpu"}}
+	stub.nodes = []*craneProtos.CranedInfo{
+		cranedInfo("cn01", 32, 64*1024, "cpu"),
+		cranedInfo("cn02", 32, 64*1024, "cpu"),
+		cranedInfo("gpu01", 64, 256*1024, "gpu"),
+	}
+	stub.qos = []*craneProtos.QosInfo{
+		{Name: "normal", MaxTimeLimitPerTask: 3600},
+		{Name: "long", MaxTimeLimitPerTask: 86400},
+	}
+	useFakeCraneCtld(t, stub)
+	SetNodeFeatures(map[string][]string{"gpu*": {"nvlink", "a100"}, "*01": {"ib"}})
+	t.Cleanup(func() { SetNodeFeatures(nil) })
+
+	shapes, err := GetPartitionShapes(context.Background(), []string{"cpu", "gpu"}, []string{"normal", "long"}, "normal")
+	require.NoError(t, err)
+	// 所有分区只查询一次
+	assert.Equal(t, 1, stub.callCount("QueryPartitionInfo"))
+
+	require.Len(t, shapes, 2)
+	cpu, gpu := shapes[0], shapes[1]
+	// 特性不同的节点不合并
+	assert.Len(t, cpu.GetNodeShapes(), 2)
+	assert.Equal(t, uint32(32), cpu.GetMaxCoresPerNode())
+	assert.Equal(t, uint64(2048), cpu.GetDefaultMemPerCoreMb())
+	assert.Equal(t, []string{"ib"}, cpu.GetFeatures())
+	assert.Equal(t, []string{"a100", "ib", "nvlink"}, gpu.GetFeatures())
+	assert.Equal(t, uint64(3600), gpu.GetDefaultTimeLimitSeconds())
+	assert.Equal(t, uint64(86400), gpu.GetMaxTimeLimitSeconds())
+
+	_, err = GetPartitionShapes(context.Background(), []string{"missing"}, nil, "")
+	assert.ErrorIs(t, err, ErrPartitionNotFound)
+}
@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+func TestOrderPartitions(t *testing.T) {
+	tests := []struct {
+		name       string
+		discovered []string
+		configured []string
+		allowList  bool
+		expected   []string
+	}{
+		{"configured first then sorted", []string{"z", "gpu", "a", "cpu"}, []string{"gpu", "cpu"}, false, []string{"gpu", "cpu", "a", "z"}},
+		{"configured but not discovered", []string{"cpu", "b"}, []string{"gpu", "cpu"}, false, []string{"cpu", "b"}},
+		{"no configured", []string{"b", "a"}, nil, false, []string{"a", "b"}},
+		{"allow list", []string{"z", "gpu", "cpu"}, []string{"gpu", "cpu"}, true, []string{"gpu", "cpu"}},
+		{"allow list not discovered", []string{"z"}, []string{"gpu"}, true, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, orderPartitions(tt.discovered, tt.configured, tt.allowList))
+		})
+	}
+}
+
+// useCraneConfigPartitions 将鹤思配置文件中的分区替换为names，测试结束后恢复
+func useCraneConfigPartitions(t *testing.T, names ...string) {
+	previous := CConfig
+	config := &CraneConfig{}
+	for _, name := range names {
+		config.Partitions = append(config.Partitions, Partition{Name: name})
+	}
+	CConfig = config
+	t.Cleanup(func() { CConfig = previous })
+}
+
+func newPartitionStub(names ...string) *fakeCraneCtld {
+	stub := newFakeCraneCtld()
+	for _, name := range names {
+		stub.partitions = append(stub.partitions, &craneProtos.PartitionInfo{Name: name})
+	}
+	return stub
+}
+
+func TestGetAllPartitionsAllowList(t *testing.T) {
+	stub := newPartitionStub("debug", "gpu", "cpu")
+	useFakeCraneCtld(t, stub)
+	useCraneConfigPartitions(t, "cpu", "gpu")
+	ctx := context.Background()
+
+	assert.Equal(t, []string{"cpu", "gpu", "debug"}, GetAllPartitions(ctx))
+	// 缓存未过期时不重新查询
+	assert.Equal(t, []string{"cpu", "gpu", "debug"}, GetAllPartitions(ctx))
+	assert.Equal(t, 1, stub.callCount("QueryPartitionInfo"))
+
+	InitPartitionDiscovery(PartitionConfig{AllowList: true})
+	assert.Equal(t, []string{"cpu", "gpu"}, GetAllPartitions(ctx))
+	assert.Equal(t, 2, stub.callCount("QueryPartitionInfo"))
+}
+
+func TestGetAllPartitionsFallback(t *testing.T) {
+	stub := newPartitionStub("debug", "cpu")
+	stub.partitionErr = errors.New("unavailable")
+	useFakeCraneCtld(t, stub)
+	useCraneConfigPartitions(t, "cpu", "gpu")
+	ctx := context.Background()
+
+	// 从未查询成功时使用配置文件中的分区
+	assert.Equal(t, []string{"cpu", "gpu"}, GetAllPartitions(ctx))
+
+	stub.mu.Lock()
+	stub.partitionErr = nil
+	stub.mu.Unlock()
+	assert.Equal(t, []string{"cpu", "debug"}, GetAllPartitions(ctx))
+
+	// 查询失败时使用上次的结果
+	stub.mu.Lock()
+	stub.partitionErr = errors.New("unavailable")
+	stub.mu.Unlock()
+	InitPartitionDiscovery(PartitionConfig{})
+	assert.Equal(t, []string{"cpu", "debug"}, GetAllPartitions(ctx))
+}
+
+func TestGetAllPartitionsQueriesWithoutLock(t *testing.T) {
+	stub := newPartitionStub("cpu")
+	stub.partitionGate = make(chan struct{})
+	useFakeCraneCtld(t, stub)
+	useCraneConfigPartitions(t)
+
+	result := make(chan []string)
+	go func() { result <- GetAllPartitions(context.Background()) }()
+	require.Eventually(t, func() bool { return stub.callCount("QueryPartitionInfo") == 1 }, time.Second, 10*time.Millisecond)
+
+	// 查询期间重新加载配置不会阻塞
+	reloaded := make(chan struct{})
+	go func() {
+		InitPartitionDiscovery(PartitionConfig{AllowList: true})
+		close(reloaded)
+	}()
+	select {
+	case <-reloaded:
+	case <-time.After(time.Second):
+		t.Fatal("InitPartitionDiscovery blocked by an in-flight partition query")
+	}
+
+	close(stub.partitionGate)
+	// 结果按新配置排序，但不写入缓存
+	assert.Equal(t, []string{}, <-result)
+	partitionDiscovery.mu.Lock()
+	assert.Nil(t, partitionDiscovery.partitions)
+	partitionDiscovery.mu.Unlock()
+}
@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+var ErrReservationNotFound = errors.New("reservation not found")
+
+// 预留名称会写入作业脚本的#CBATCH行，只允许字母、数字、下划线、点和横线
+var reservationNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// CheckReservationName 检查预留名称是否非法，与账户名一样不超过30个字符
+func CheckReservationName(name string) error {
+	if name == "" {
+		return fmt.Errorf("reservation name is empty")
+	}
+	if len(name) > 30 {
+		return fmt.Errorf("reservation name is too long (up to 30)")
+	}
+	if !reservationNamePattern.MatchString(name) {
+		return fmt.Errorf("reservation name %q contains characters other than letters, digits, '_', '.' and '-'", name)
+	}
+	return nil
+}
+
+// CheckReservation 检查创建预留的参数，名称需要合法，分区需要存在，持续时间需要大于0
+func CheckReservation(ctx context.Context, in *adapterProtos.CreateReservationRequest) error {
+	if err := CheckReservationName(in.GetReservationName()); err != nil {
+		return err
+	}
+	if in.GetDurationSeconds() <= 0 {
+		return fmt.Errorf("duration must be positive")
+	}
+	if !Contains(GetAllPartitions(ctx), in.GetPartition()) {
+		return fmt.Errorf("partition %v not found", in.GetPartition())
+	}
+	return nil
+}
+
+// CreateReservation 在分区中预留节点，startTime为空时从当前时间开始
+func CreateReservation(ctx context.Context, in *adapterProtos.CreateReservationRequest) error {
+	startTime := time.Now().Unix()
+	if in.StartTime != nil {
+		startTime = in.GetStartTime().GetSeconds()
+	}
+	request := &craneProtos.CreateReservationRequest{
+		Uid:                  uint32(os.Getuid()),
+		ReservationName:      in.GetReservationName(),
+		StartTimeUnixSeconds: startTime,
+		DurationSeconds:      in.GetDurationSeconds(),
+		Partition:            in.GetPartition(),
+		CranedRegex:          in.GetNodeList(),
+		AllowedAccounts:      in.GetAllowedAccounts(),
+		AllowedUsers:         in.GetAllowedUsers(),
+	}
+	response, err := CraneCtld.CreateReservation(ctx, request)
+	if err != nil {
+		return err
+	}
+	if !response.GetOk() {
+		return fmt.Errorf("create reservation %v failed: %v", in.GetReservationName(), response.GetReason())
+	}
+	return nil
+}
+
+// DeleteReservation 删除预留
+func DeleteReservation(ctx context.Context, reservationName string) error {
+	request := &craneProtos.DeleteReservationRequest{
+		Uid:             uint32(os.Getuid()),
+		ReservationName: reservationName,
+	}
+	response, err := CraneCtld.DeleteReservation(ctx, request)
+	if err != nil {
+		return err
+	}
+	if !response.GetOk() {
+		return fmt.Errorf("delete reservation %v failed: %v", reservationName, response.GetReason())
+	}
+	return nil
+}
+
+// GetReservations 查询所有预留
+func GetReservations(ctx context.Context) ([]*adapterProtos.ReservationInfo, error) {
+	response, err := CraneCtld.QueryReservationInfo(ctx, &craneProtos.QueryReservationInfoRequest{
+		Uid: uint32(os.Getuid()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !response.GetOk() {
+		return nil, fmt.Errorf("query reservations failed: %v", response.GetReason())
+	}
+
+	var reservations []*adapterProtos.ReservationInfo
+	for _, reservation := range response.GetReservationInfoList() {
+		reservations = append(reservations, toReservationInfo(reservation))
+	}
+	return reservations, nil
+}
+
+// GetReservation 根据名称查询预留，不存在时返回ErrReservationNotFound
+func GetReservation(ctx context.Context, reservationName string) (*adapterProtos.ReservationInfo, error) {
+	reservations, err := GetReservations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, reservation := range reservations {
+		if reservation.GetReservationName() == reservationName {
+			return reservation, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %v", ErrReservationNotFound, reservationName)
+}
+
+func toReservationInfo(reservation *craneProtos.ReservationInfo) *adapterProtos.ReservationInfo {
+	return &adapterProtos.ReservationInfo{
+		ReservationName: reservation.GetReservationName(),
+		Partition:       reservation.GetPartition(),
+		NodeList:        reservation.GetCranedRegex(),
+		StartTime:       reservation.GetStartTime(),
+		DurationSeconds: reservation.GetDuration().GetSeconds(),
+		AllowedAccounts: reservation.GetAllowedAccounts(),
+		AllowedUsers:    reservation.GetAllowedUsers(),
+		TotalCpuCores:   uint32(reservation.GetResTotal().GetAllocatableRes().GetCpuCoreLimit()),
+		AllocCpuCores:   uint32(reservation.GetResAlloc().GetAllocatableRes().GetCpuCoreLimit()),
+		AvailCpuCores:   uint32(reservation.GetResAvail().GetAllocatableRes().GetCpuCoreLimit()),
+	}
+}
@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckReservationName(t *testing.T) {
+	for _, name := range []string{"training", "maint-2024.01", "course_A"} {
+		assert.NoError(t, CheckReservationName(name), name)
+	}
+
+	// 名称会拼接到作业脚本中，不能包含空白、换行等字符
+	for _, name := range []string{"", "a b", "a\n#CBATCH --uid 0", "a\tb", "a;b", "预留", strings.Repeat("a", 31)} {
+		assert.Error(t, CheckReservationName(name), name)
+	}
+}
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// SetUserAdminLevel 设置用户的管理级别
+func SetUserAdminLevel(ctx context.Context, userName string, level craneProtos.UserInfo_AdminLevel) error {
+	if level == craneProtos.UserInfo_Root {
+		return fmt.Errorf("admin level %v can not be set", level)
+	}
+	return modifyUserField(ctx, userName, "", "", craneProtos.ModifyField_AdminLevel, craneProtos.OperationType_Overwrite, []string{strings.ToLower(level.String())})
+}
+
+// GetUserInAccount 查询用户在账户中的信息
+func GetUserInAccount(ctx context.Context, userName, accountName string) (*craneProtos.UserInfo, error) {
+	request := &craneProtos.QueryUserInfoRequest{
+		Uid:      0,
+		UserList: []string{userName},
+		Account:  accountName,
+	}
+	response, err := CraneCtld.QueryUserInfo(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if !response.GetOk() {
+		return nil, fmt.Errorf("query user %v in account %v failed: %v", userName, accountName, richErrorMessage(response.GetRichErrorList()))
+	}
+	for _, user := range response.GetUserList() {
+		if user.GetName() == userName && user.GetAccount() == accountName {
+			return user, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: user %v not in account %v", ErrUserNotInAccount, userName, accountName)
+}
+
+func addUser(ctx context.Context, user *craneProtos.UserInfo) error {
+	request := &craneProtos.AddUserRequest{
+		Uid:  0,
+		User: user,
+	}
+	response, err := CraneCtld.AddUser(ctx, request)
+	if err != nil {
+		return err
+	}
+	if !response.GetOk() {
+		return fmt.Errorf("add user failed, code: %v", response.GetCode())
+	}
+	return nil
+}
+
+// GetAccountCoordinators 获取账户的协调者
+func GetAccountCoordinators(ctx context.Context, accountNames []string) (map[string][]string, error) {
+	request := &craneProtos.QueryAccountInfoRequest{
+		Uid:         0,
+		AccountList: accountNames,
+	}
+	response, err := CraneCtld.QueryAccountInfo(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if !response.GetOk() {
+		return nil, fmt.Errorf("query accounts failed: %v", richErrorMessage(response.GetRichErrorList()))
+	}
+
+	coordinators := make(map[string][]string)
+	for _, account := range response.GetAccountList() {
+		coordinators[account.GetName()] = account.GetCoordinators()
+	}
+	return coordinators, nil
+}
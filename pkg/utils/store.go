@@ -0,0 +1,197 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+)
+
+// 状态目录中的锁文件，同一时刻只允许一个进程打开状态目录
+const stateDirLockFile = ".lock"
+
+// ErrStateDirLocked 状态目录正在被其他进程(适配器服务或import、export子命令)使用
+var ErrStateDirLocked = errors.New("state dir is in use by another process")
+
+// FileStore 基于本地JSON文件的键值存储，保存适配器自身需要持久化、而鹤思中没有对应字段的状态
+type FileStore struct {
+	mu   sync.Mutex
+	path string
+	data map[string]json.RawMessage
+}
+
+var (
+	PartitionGrantStore       *FileStore
+	AccountLimitStore         *FileStore
+	UserLimitStore            *FileStore
+	ArchivedAccountStore      *FileStore
+	UserOverrideStore         *FileStore
+	UserBlockedPartitionStore *FileStore
+	NodeDrainStore            *FileStore
+	CascadeBlockStore         *FileStore
+
+	// 当前持有的状态目录锁，进程退出时由系统释放
+	stateDirLock *os.File
+)
+
+// InitStateStore 在stateDir下打开适配器的各状态文件，相对路径按当前工作目录解析
+// 各存储只在启动时读取文件，之后整体覆盖写入，因此先对状态目录加锁，避免多个进程互相覆盖对方的修改
+func InitStateStore(stateDir string) error {
+	absStateDir, err := filepath.Abs(stateDir)
+	if err != nil {
+		return fmt.Errorf("resolve state dir %v failed: %v", stateDir, err)
+	}
+	stateDir = absStateDir
+	if err = os.MkdirAll(stateDir, 0700); err != nil {
+		return fmt.Errorf("create state dir %v failed: %v", stateDir, err)
+	}
+	if err = lockStateDir(stateDir); err != nil {
+		return err
+	}
+	logrus.Infof("state dir: %v", stateDir)
+
+	stores := map[string]**FileStore{
+		"partition_grants.json":        &PartitionGrantStore,
+		"account_limits.json":          &AccountLimitStore,
+		"user_limits.json":             &UserLimitStore,
+		"archived_accounts.json":       &ArchivedAccountStore,
+		"user_overrides.json":          &UserOverrideStore,
+		"user_blocked_partitions.json": &UserBlockedPartitionStore,
+		"node_drains.json":             &NodeDrainStore,
+		"cascade_blocks.json":          &CascadeBlockStore,
+	}
+	for name, store := range stores {
+		s, err := OpenFileStore(filepath.Join(stateDir, name))
+		if err != nil {
+			return err
+		}
+		*store = s
+	}
+	return nil
+}
+
+// lockStateDir 对状态目录加排他锁，已持有该目录的锁时不做处理，切换目录时释放之前的锁
+func lockStateDir(stateDir string) error {
+	path := filepath.Join(stateDir, stateDirLockFile)
+	if stateDirLock != nil && stateDirLock.Name() == path {
+		return nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return fmt.Errorf("open state dir lock %v failed: %v", path, err)
+	}
+	if err = syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		file.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return fmt.Errorf("%w: %v, stop the other process first", ErrStateDirLocked, stateDir)
+		}
+		return fmt.Errorf("lock state dir %v failed: %v", stateDir, err)
+	}
+
+	if stateDirLock != nil {
+		stateDirLock.Close()
+	}
+	stateDirLock = file
+	return nil
+}
+
+// OpenFileStore 打开path对应的存储文件，文件不存在时视为空存储
+func OpenFileStore(path string) (*FileStore, error) {
+	s := &FileStore{
+		path: path,
+		data: make(map[string]json.RawMessage),
+	}
+
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read store %v failed: %v", path, err)
+	}
+	if len(content) == 0 {
+		return s, nil
+	}
+	if err = json.Unmarshal(content, &s.data); err != nil {
+		return nil, fmt.Errorf("parse store %v failed: %v", path, err)
+	}
+	return s, nil
+}
+
+// Get 读取key对应的值到v中，key不存在时返回false
+func (s *FileStore) Get(key string, v interface{}) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, ok := s.data[key]
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return false, fmt.Errorf("decode %v in store %v failed: %v", key, s.path, err)
+	}
+	return true, nil
+}
+
+// Put 写入key对应的值并落盘
+func (s *FileStore) Put(key string, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode %v failed: %v", key, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data[key] = raw
+	return s.flush()
+}
+
+// Delete 删除key并落盘，key不存在时不做处理
+func (s *FileStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.data[key]; !ok {
+		return nil
+	}
+	delete(s.data, key)
+	return s.flush()
+}
+
+// Keys 返回存储中所有的key，按字典序排列
+func (s *FileStore) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.data))
+	for key := range s.data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// 先写临时文件再重命名，避免写到一半时进程退出导致文件损坏
+func (s *FileStore) flush() error {
+	content, err := json.MarshalIndent(s.data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode store %v failed: %v", s.path, err)
+	}
+
+	tmpPath := s.path + ".tmp"
+	if err = os.WriteFile(tmpPath, content, 0600); err != nil {
+		return fmt.Errorf("write store %v failed: %v", s.path, err)
+	}
+	if err = os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("write store %v failed: %v", s.path, err)
+	}
+	return nil
+}
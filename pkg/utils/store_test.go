@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitStateStoreLocksStateDir(t *testing.T) {
+	useFakeCraneCtld(t, newFakeCraneCtld())
+
+	// 相对路径解析为绝对路径
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { os.Chdir(wd) })
+	require.NoError(t, InitStateStore("data"))
+	assert.Equal(t, filepath.Join(dir, "data", stateDirLockFile), stateDirLock.Name())
+
+	// 重复初始化同一目录时沿用已持有的锁
+	require.NoError(t, InitStateStore(filepath.Join(dir, "data")))
+
+	// 其他打开方持有锁时拒绝使用该目录，flock的锁属于打开的文件，同一进程再次打开同样会冲突
+	other := filepath.Join(dir, "other")
+	require.NoError(t, os.MkdirAll(other, 0700))
+	file, err := os.OpenFile(filepath.Join(other, stateDirLockFile), os.O_CREATE|os.O_RDWR, 0600)
+	require.NoError(t, err)
+	defer file.Close()
+	require.NoError(t, syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB))
+	assert.ErrorIs(t, InitStateStore(other), ErrStateDirLocked)
+}
@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+// GetUserBlockedPartitions 获取用户在账户中被封锁的分区
+func GetUserBlockedPartitions(userName, accountName string) ([]string, error) {
+	if UserBlockedPartitionStore == nil {
+		return nil, nil
+	}
+	var partitions []string
+	if _, err := UserBlockedPartitionStore.Get(userOverrideKey(userName, accountName), &partitions); err != nil {
+		return nil, err
+	}
+	return partitions, nil
+}
+
+func saveUserBlockedPartitions(userName, accountName string, partitions []string) error {
+	if UserBlockedPartitionStore == nil {
+		return nil
+	}
+	if len(partitions) == 0 {
+		return UserBlockedPartitionStore.Delete(userOverrideKey(userName, accountName))
+	}
+	return UserBlockedPartitionStore.Put(userOverrideKey(userName, accountName), partitions)
+}
+
+// DeleteUserBlockedPartitions 删除用户的分区封锁记录，用户移出账户后调用
+func DeleteUserBlockedPartitions(userName, accountName string) error {
+	return saveUserBlockedPartitions(userName, accountName, nil)
+}
+
+// BlockUserInAccountWithPartition 在分区上封锁账户中的用户，从用户的可用分区中删除这些分区并记录
+func BlockUserInAccountWithPartition(ctx context.Context, userName, accountName string, partitions []string) error {
+	userPartitionMu.Lock()
+	defer userPartitionMu.Unlock()
+
+	if err := checkPartitionsExist(ctx, partitions); err != nil {
+		return err
+	}
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+	if !Contains(account.GetUsers(), userName) {
+		return fmt.Errorf("%w: user %v, account %v", ErrUserNotInAccount, userName, accountName)
+	}
+
+	blockedPartitions, err := GetUserBlockedPartitions(userName, accountName)
+	if err != nil {
+		return err
+	}
+	newPartitions := SliceSubtract(partitions, blockedPartitions)
+	if len(newPartitions) == 0 {
+		logrus.Infof("BlockUserInAccountWithPartition user %v account %v already blocked in partitions %v", userName, accountName, partitions)
+		return nil
+	}
+
+	if err = saveUserBlockedPartitions(userName, accountName, append(blockedPartitions, newPartitions...)); err != nil {
+		return err
+	}
+	override, err := GetUserPartitionQos(userName, accountName)
+	if err != nil {
+		return err
+	}
+	if err = applyUserPartitionQos(ctx, userName, account, override); err != nil {
+		// 鹤思修改失败时恢复原来的记录
+		if restoreErr := saveUserBlockedPartitions(userName, accountName, blockedPartitions); restoreErr != nil {
+			logrus.Errorf("BlockUserInAccountWithPartition restore blocked partitions of user %v failed: %v", userName, restoreErr)
+		}
+		return err
+	}
+
+	logrus.Infof("BlockUserInAccountWithPartition user %v account %v partitions %v success", userName, accountName, newPartitions)
+	return nil
+}
+
+// UnblockUserInAccountWithPartition 在分区上解封账户中的用户，账户当前不可用的分区解封后也不会加回
+func UnblockUserInAccountWithPartition(ctx context.Context, userName, accountName string, partitions []string) error {
+	userPartitionMu.Lock()
+	defer userPartitionMu.Unlock()
+
+	if err := checkPartitionsExist(ctx, partitions); err != nil {
+		return err
+	}
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+	if !Contains(account.GetUsers(), userName) {
+		return fmt.Errorf("%w: user %v, account %v", ErrUserNotInAccount, userName, accountName)
+	}
+
+	blockedPartitions, err := GetUserBlockedPartitions(userName, accountName)
+	if err != nil {
+		return err
+	}
+	remainPartitions := SliceSubtract(blockedPartitions, partitions)
+	if len(remainPartitions) == len(blockedPartitions) {
+		logrus.Infof("UnblockUserInAccountWithPartition user %v account %v not blocked in partitions %v", userName, accountName, partitions)
+		return nil
+	}
+
+	if err = saveUserBlockedPartitions(userName, accountName, remainPartitions); err != nil {
+		return err
+	}
+	override, err := GetUserPartitionQos(userName, accountName)
+	if err != nil {
+		return err
+	}
+	if err = applyUserPartitionQos(ctx, userName, account, override); err != nil {
+		if restoreErr := saveUserBlockedPartitions(userName, accountName, blockedPartitions); restoreErr != nil {
+			logrus.Errorf("UnblockUserInAccountWithPartition restore blocked partitions of user %v failed: %v", userName, restoreErr)
+		}
+		return err
+	}
+
+	logrus.Infof("UnblockUserInAccountWithPartition user %v account %v partitions %v success", userName, accountName, partitions)
+	return nil
+}
+
+// getUserPartitionSettings 获取用户的单独设置，custom表示用户有单独设置或分区封锁记录，需要按用户自己的设置调整分区
+func getUserPartitionSettings(userName, accountName string) (override *UserPartitionQos, custom bool, err error) {
+	override, err = GetUserPartitionQos(userName, accountName)
+	if err != nil {
+		return nil, false, err
+	}
+	blockedPartitions, err := GetUserBlockedPartitions(userName, accountName)
+	if err != nil {
+		return nil, false, err
+	}
+	return override, override != nil || len(blockedPartitions) != 0, nil
+}
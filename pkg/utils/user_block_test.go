@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+func TestUserPartitionBlockValidation(t *testing.T) {
+	stub := newFakeCraneCtld(&craneProtos.AccountInfo{Name: "a", Users: []string{"u1"}, AllowedPartitions: []string{"p1"}})
+	stub.partitions = []*craneProtos.PartitionInfo{{Name: "p1"}}
+	useFakeCraneCtld(t, stub)
+	ctx := context.Background()
+
+	assert.ErrorIs(t, BlockUserInAccountWithPartition(ctx, "u1", "a", []string{"p2"}), ErrPartitionNotFound)
+	assert.ErrorIs(t, BlockUserInAccountWithPartition(ctx, "u2", "a", []string{"p1"}), ErrUserNotInAccount)
+	assert.ErrorIs(t, UnblockUserInAccountWithPartition(ctx, "u1", "a", []string{"p2"}), ErrPartitionNotFound)
+	assert.ErrorIs(t, UnblockUserInAccountWithPartition(ctx, "u2", "a", []string{"p1"}), ErrUserNotInAccount)
+	assert.ErrorIs(t, BlockUserInAccountWithPartition(ctx, "u1", "missing", []string{"p1"}), ErrAccountNotFound)
+}
@@ -0,0 +1,231 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+// UserPartitionQos 用户在账户下单独设置的分区和qos，字段为空时使用账户的设置
+type UserPartitionQos struct {
+	Partitions []string `json:"partitions,omitempty"`
+	QosList    []string `json:"qos_list,omitempty"`
+	DefaultQos string   `json:"default_qos,omitempty"`
+}
+
+// 保证同一时刻只有一个请求在修改用户的单独设置和分区封锁记录，以及按这些记录调整用户的分区和qos
+// 需要同时持有grantMu时先获取grantMu
+var userPartitionMu sync.Mutex
+
+func userOverrideKey(userName, accountName string) string {
+	return accountName + "/" + userName
+}
+
+// GetUserPartitionQos 获取用户单独设置的分区和qos，未设置时返回nil
+func GetUserPartitionQos(userName, accountName string) (*UserPartitionQos, error) {
+	if UserOverrideStore == nil {
+		return nil, nil
+	}
+	override := &UserPartitionQos{}
+	exist, err := UserOverrideStore.Get(userOverrideKey(userName, accountName), override)
+	if err != nil || !exist {
+		return nil, err
+	}
+	return override, nil
+}
+
+// userTargetPartitionQos 计算用户在账户当前可用分区下应有的分区、qos和默认qos
+func userTargetPartitionQos(account *craneProtos.AccountInfo, override *UserPartitionQos) ([]string, []string, string) {
+	partitions := account.GetAllowedPartitions()
+	qosList := account.GetAllowedQosList()
+	defaultQos := account.GetDefaultQos()
+	if override == nil {
+		return partitions, qosList, defaultQos
+	}
+
+	if len(override.Partitions) != 0 {
+		var targetPartitions []string
+		for _, partition := range partitions {
+			if Contains(override.Partitions, partition) {
+				targetPartitions = append(targetPartitions, partition)
+			}
+		}
+		partitions = targetPartitions
+	}
+	// 账户的qos修改后，单独设置中不再被账户允许的qos不生效
+	var targetQosList []string
+	for _, qos := range override.QosList {
+		if Contains(qosList, qos) {
+			targetQosList = append(targetQosList, qos)
+		}
+	}
+	if len(targetQosList) != 0 {
+		qosList = targetQosList
+	}
+	if override.DefaultQos != "" && Contains(qosList, override.DefaultQos) {
+		defaultQos = override.DefaultQos
+	}
+	if len(qosList) != 0 && !Contains(qosList, defaultQos) {
+		defaultQos = qosList[0]
+	}
+	return partitions, qosList, defaultQos
+}
+
+// applyUserPartitionQos 将用户在账户下的分区和qos调整为目标值，用户被封锁的分区不会加回
+func applyUserPartitionQos(ctx context.Context, userName string, account *craneProtos.AccountInfo, override *UserPartitionQos) error {
+	user, err := GetUserInAccount(ctx, userName, account.GetName())
+	if err != nil {
+		return err
+	}
+	blockedPartitions, err := GetUserBlockedPartitions(userName, account.GetName())
+	if err != nil {
+		return err
+	}
+	partitions, qosList, defaultQos := userTargetPartitionQos(account, override)
+	partitions = SliceSubtract(partitions, blockedPartitions)
+
+	userPartitionQos := make(map[string]*craneProtos.UserInfo_AllowedPartitionQos)
+	var userPartitions []string
+	for _, partitionQos := range user.GetAllowedPartitionQosList() {
+		userPartitionQos[partitionQos.GetPartitionName()] = partitionQos
+		userPartitions = append(userPartitions, partitionQos.GetPartitionName())
+	}
+
+	if deletePartitions := SliceSubtract(userPartitions, partitions); len(deletePartitions) != 0 {
+		if err = modifyUserField(ctx, userName, account.GetName(), "", craneProtos.ModifyField_Partition, craneProtos.OperationType_Delete, deletePartitions); err != nil {
+			return err
+		}
+	}
+	if addPartitions := SliceSubtract(partitions, userPartitions); len(addPartitions) != 0 {
+		if err = modifyUserField(ctx, userName, account.GetName(), "", craneProtos.ModifyField_Partition, craneProtos.OperationType_Add, addPartitions); err != nil {
+			return err
+		}
+	}
+
+	// 默认qos必须在允许列表中，所以先添加新的qos，再修改默认qos，最后删除不再允许的qos
+	for _, partition := range partitions {
+		var currentQos []string
+		currentDefaultQos := ""
+		if partitionQos, ok := userPartitionQos[partition]; ok {
+			currentQos = partitionQos.GetQosList()
+			currentDefaultQos = partitionQos.GetDefaultQos()
+		} else {
+			// 新添加的分区使用账户的qos
+			currentQos = account.GetAllowedQosList()
+			currentDefaultQos = account.GetDefaultQos()
+		}
+
+		if addQos := SliceSubtract(qosList, currentQos); len(addQos) != 0 {
+			if err = modifyUserField(ctx, userName, account.GetName(), partition, craneProtos.ModifyField_Qos, craneProtos.OperationType_Add, addQos); err != nil {
+				return err
+			}
+		}
+		if currentDefaultQos != defaultQos {
+			if err = modifyUserField(ctx, userName, account.GetName(), partition, craneProtos.ModifyField_DefaultQos, craneProtos.OperationType_Overwrite, []string{defaultQos}); err != nil {
+				return err
+			}
+		}
+		if deleteQos := SliceSubtract(currentQos, qosList); len(deleteQos) != 0 {
+			if err = modifyUserField(ctx, userName, account.GetName(), partition, craneProtos.ModifyField_Qos, craneProtos.OperationType_Delete, deleteQos); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SetUserPartitionQos 单独设置用户在账户下可用的分区和qos
+func SetUserPartitionQos(ctx context.Context, userName, accountName string, override *UserPartitionQos) error {
+	userPartitionMu.Lock()
+	defer userPartitionMu.Unlock()
+
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+	if !Contains(account.GetUsers(), userName) {
+		return fmt.Errorf("%w: user %v, account %v", ErrUserNotInAccount, userName, accountName)
+	}
+
+	grant, err := GetPartitionGrant(ctx, account)
+	if err != nil {
+		return err
+	}
+	for _, partition := range override.Partitions {
+		if !Contains(grant.Granted, partition) {
+			return fmt.Errorf("%w: partition %v, account %v", ErrPartitionNotGranted, partition, accountName)
+		}
+	}
+	for _, qos := range override.QosList {
+		if !Contains(account.GetAllowedQosList(), qos) {
+			return fmt.Errorf("%w: qos %v, account %v", ErrQosNotAllowed, qos, accountName)
+		}
+	}
+	if override.DefaultQos != "" {
+		qosList := override.QosList
+		if len(qosList) == 0 {
+			qosList = account.GetAllowedQosList()
+		}
+		if !Contains(qosList, override.DefaultQos) {
+			return fmt.Errorf("%w: default qos %v is not in allowed qos list %v", ErrInvalidQos, override.DefaultQos, qosList)
+		}
+	}
+
+	if err = applyUserPartitionQos(ctx, userName, account, override); err != nil {
+		return err
+	}
+
+	logrus.Infof("SetUserPartitionQos user %v account %v override %+v success", userName, accountName, override)
+	if UserOverrideStore == nil {
+		return nil
+	}
+	return UserOverrideStore.Put(userOverrideKey(userName, accountName), override)
+}
+
+// ClearUserPartitionQos 清除用户的单独设置，恢复为账户的分区和qos
+func ClearUserPartitionQos(ctx context.Context, userName, accountName string) error {
+	userPartitionMu.Lock()
+	defer userPartitionMu.Unlock()
+
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return err
+	}
+	if !Contains(account.GetUsers(), userName) {
+		return fmt.Errorf("%w: user %v, account %v", ErrUserNotInAccount, userName, accountName)
+	}
+	if err = applyUserPartitionQos(ctx, userName, account, nil); err != nil {
+		return err
+	}
+
+	logrus.Infof("ClearUserPartitionQos user %v account %v success", userName, accountName)
+	return DeleteUserPartitionQos(userName, accountName)
+}
+
+// DeleteUserPartitionQos 删除用户的单独设置记录，用户移出账户后调用
+func DeleteUserPartitionQos(userName, accountName string) error {
+	if UserOverrideStore == nil {
+		return nil
+	}
+	return UserOverrideStore.Delete(userOverrideKey(userName, accountName))
+}
+
+// GetUserAllowedPartitionQos 获取用户在账户下实际可用的分区和qos
+func GetUserAllowedPartitionQos(ctx context.Context, userName, accountName string) ([]*craneProtos.UserInfo_AllowedPartitionQos, error) {
+	account, err := GetAccountByName(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if !Contains(account.GetUsers(), userName) {
+		return nil, fmt.Errorf("%w: user %v, account %v", ErrUserNotInAccount, userName, accountName)
+	}
+	user, err := GetUserInAccount(ctx, userName, accountName)
+	if err != nil {
+		return nil, err
+	}
+	return user.GetAllowedPartitionQosList(), nil
+}
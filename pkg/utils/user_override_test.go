@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	craneProtos "scow-crane-adapter/gen/crane"
+)
+
+func TestSetUserPartitionQosValidation(t *testing.T) {
+	stub := newFakeCraneCtld(&craneProtos.AccountInfo{
+		Name:              "a",
+		Users:             []string{"u1"},
+		AllowedPartitions: []string{"p1"},
+		AllowedQosList:    []string{"q1", "q2"},
+		DefaultQos:        "q1",
+	})
+	useFakeCraneCtld(t, stub)
+	require.NoError(t, savePartitionGrant("a", &PartitionGrant{Granted: []string{"p1"}}))
+	ctx := context.Background()
+
+	tests := []struct {
+		user     string
+		override *UserPartitionQos
+		err      error
+	}{
+		{"u2", &UserPartitionQos{Partitions: []string{"p1"}}, ErrUserNotInAccount},
+		{"u1", &UserPartitionQos{Partitions: []string{"p2"}}, ErrPartitionNotGranted},
+		{"u1", &UserPartitionQos{QosList: []string{"q3"}}, ErrQosNotAllowed},
+		{"u1", &UserPartitionQos{QosList: []string{"q1"}, DefaultQos: "q2"}, ErrInvalidQos},
+	}
+	for _, test := range tests {
+		assert.ErrorIs(t, SetUserPartitionQos(ctx, test.user, "a", test.override), test.err)
+	}
+	assert.ErrorIs(t, ClearUserPartitionQos(ctx, "u2", "a"), ErrUserNotInAccount)
+}
@@ -14,7 +14,6 @@ import (
 	"runtime"
 	"slices"
 	"sort"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -127,14 +126,13 @@ func LoadDBConfig(configPath string) (*DatabaseConfig, error) {
 	return config, nil
 }
 
-// GetUidByUserName 通过os/user包去获取用户的uid
-func GetUidByUserName(userName string) (int, error) {
-	u, err := user.Lookup(userName)
+// GetUidByUserName 通过配置的身份源获取用户的uid
+func GetUidByUserName(ctx context.Context, userName string) (int, error) {
+	uid, err := Identity.LookupUid(ctx, userName)
 	if err != nil {
-		return 0, fmt.Errorf("Failed to lookup user: %s\n", err)
+		return 0, fmt.Errorf("failed to lookup user %v: %w", userName, err)
 	}
-	uid, _ := strconv.Atoi(u.Uid)
-	return uid, nil
+	return int(uid), nil
 }
 
 // RichError rich error model 封装
@@ -147,13 +145,24 @@ func RichError(code codes.Code, reason string, message string) error {
 	return st.Err()
 }
 
+// RichErrorWithMetadata 在rich error的ErrorInfo中附带额外信息
+func RichErrorWithMetadata(code codes.Code, reason string, message string, metadata map[string]string) error {
+	errInfo := &errdetails.ErrorInfo{
+		Reason:   reason,
+		Metadata: metadata,
+	}
+	st := status.New(code, message)
+	st, _ = st.WithDetails(errInfo)
+	return st.Err()
+}
+
 // GetQos 获取系统中Qos列表
-func GetQos() ([]string, error) {
+func GetQos(ctx context.Context) ([]string, error) {
 	var qosList []string
 	request := &craneProtos.QueryQosInfoRequest{
 		Uid: uint32(os.Getuid()),
 	}
-	response, err := CraneCtld.QueryQosInfo(context.Background(), request)
+	response, err := CraneCtld.QueryQosInfo(ctx, request)
 	if err != nil {
 		return []string{}, err
 	}
@@ -164,8 +173,8 @@ func GetQos() ([]string, error) {
 	return qosList, nil
 }
 
-func GetAllQos() ([]string, error) {
-	qosList, err := GetQos()
+func GetAllQos(ctx context.Context) ([]string, error) {
+	qosList, err := GetQos(ctx)
 	if err != nil {
 		return []string{}, err
 	}
@@ -177,11 +186,11 @@ func GetAllQos() ([]string, error) {
 	return qosListValue, nil
 }
 
-func GetAllAccount() ([]*craneProtos.AccountInfo, error) {
+func GetAllAccount(ctx context.Context) ([]*craneProtos.AccountInfo, error) {
 	request := &craneProtos.QueryAccountInfoRequest{
 		Uid: 0,
 	}
-	response, err := CraneCtld.QueryAccountInfo(context.Background(), request)
+	response, err := CraneCtld.QueryAccountInfo(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -199,28 +208,28 @@ func CheckAccount(name string) error {
 	return nil
 }
 
-func GetAccountByName(accountName string) (*craneProtos.AccountInfo, error) {
+func GetAccountByName(ctx context.Context, accountName string) (*craneProtos.AccountInfo, error) {
 	request := &craneProtos.QueryAccountInfoRequest{
 		Uid:         0,
 		AccountList: []string{accountName},
 	}
-	response, err := CraneCtld.QueryAccountInfo(context.Background(), request)
+	response, err := CraneCtld.QueryAccountInfo(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 	if !response.GetOk() {
-		return nil, fmt.Errorf("failed get account %v, error: %v", accountName, response.RichErrorList[0].GetDescription())
+		return nil, fmt.Errorf("%w: failed get account %v, error: %v", ErrAccountNotFound, accountName, response.RichErrorList[0].GetDescription())
 	}
 	return response.GetAccountList()[0], nil
 }
 
-func GetAccountByUser(userName string) ([]string, error) {
+func GetAccountByUser(ctx context.Context, userName string) ([]string, error) {
 	var accountList []string
 	request := &craneProtos.QueryUserInfoRequest{
 		Uid:      0,
 		UserList: []string{userName},
 	}
-	response, err := CraneCtld.QueryUserInfo(context.Background(), request)
+	response, err := CraneCtld.QueryUserInfo(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -239,11 +248,11 @@ func GetAccountByUser(userName string) ([]string, error) {
 	return accountList, nil
 }
 
-func GetAllUser() ([]*craneProtos.UserInfo, error) {
+func GetAllUser(ctx context.Context) ([]*craneProtos.UserInfo, error) {
 	request := &craneProtos.QueryUserInfoRequest{
 		Uid: 0,
 	}
-	response, err := CraneCtld.QueryUserInfo(context.Background(), request)
+	response, err := CraneCtld.QueryUserInfo(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -254,7 +263,7 @@ func GetAllUser() ([]*craneProtos.UserInfo, error) {
 }
 
 // GetAllAccountUserInfoMap 获取账户下每个用户的信息
-func GetAllAccountUserInfoMap(allAccounts []*craneProtos.AccountInfo) (map[*craneProtos.AccountInfo][]*craneProtos.UserInfo, error) {
+func GetAllAccountUserInfoMap(ctx context.Context, allAccounts []*craneProtos.AccountInfo) (map[*craneProtos.AccountInfo][]*craneProtos.UserInfo, error) {
 	accountUserInfo := make(map[*craneProtos.AccountInfo][]*craneProtos.UserInfo)
 
 	for _, account := range allAccounts {
@@ -262,7 +271,7 @@ func GetAllAccountUserInfoMap(allAccounts []*craneProtos.AccountInfo) (map[*cran
 			Uid:     0,
 			Account: account.Name,
 		}
-		response, err := CraneCtld.QueryUserInfo(context.Background(), request)
+		response, err := CraneCtld.QueryUserInfo(ctx, request)
 		if err != nil {
 			return nil, err
 		}
@@ -285,7 +294,7 @@ func GetAllAccountUserInfoMap(allAccounts []*craneProtos.AccountInfo) (map[*cran
 	return accountUserInfo, nil
 }
 
-func GetAllAccountUserInfoConcurrently(allAccounts []*craneProtos.AccountInfo) (map[*craneProtos.AccountInfo][]*craneProtos.UserInfo, error) {
+func GetAllAccountUserInfoConcurrently(ctx context.Context, allAccounts []*craneProtos.AccountInfo) (map[*craneProtos.AccountInfo][]*craneProtos.UserInfo, error) {
 	var (
 		wg              sync.WaitGroup
 		mu              sync.Mutex
@@ -321,7 +330,7 @@ func GetAllAccountUserInfoConcurrently(allAccounts []*craneProtos.AccountInfo) (
 					Uid:     0,
 					Account: account.Name,
 				}
-				response, err := CraneCtld.QueryUserInfo(context.Background(), request)
+				response, err := CraneCtld.QueryUserInfo(ctx, request)
 				if err != nil {
 					select {
 					case errChan <- fmt.Errorf("account %s query error: %v", account.Name, err):
@@ -371,11 +380,48 @@ func GetAllAccountUserInfoConcurrently(allAccounts []*craneProtos.AccountInfo) (
 	}
 }
 
-func GetPartitionByName(partitionName string) (*craneProtos.PartitionInfo, error) {
+// AccountUsers 账户及其用户
+type AccountUsers struct {
+	Account *craneProtos.AccountInfo
+	Users   []*craneProtos.UserInfo
+}
+
+// GetAccountsWithUsers 获取账户及其用户，按账户名排列，accountNames为空时获取所有未归档的账户
+func GetAccountsWithUsers(ctx context.Context, accountNames []string) ([]*AccountUsers, error) {
+	var accounts []*craneProtos.AccountInfo
+	if len(accountNames) == 0 {
+		allAccount, err := GetAllAccount(ctx)
+		if err != nil {
+			return nil, err
+		}
+		accounts = FilterArchivedAccounts(allAccount)
+	} else {
+		for _, accountName := range accountNames {
+			account, err := GetAccountByName(ctx, accountName)
+			if err != nil {
+				return nil, err
+			}
+			accounts = append(accounts, account)
+		}
+	}
+
+	accountUserInfoMap, err := GetAllAccountUserInfoConcurrently(ctx, accounts)
+	if err != nil {
+		return nil, err
+	}
+	var result []*AccountUsers
+	for _, account := range accounts {
+		result = append(result, &AccountUsers{Account: account, Users: accountUserInfoMap[account]})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Account.GetName() < result[j].Account.GetName() })
+	return result, nil
+}
+
+func GetPartitionByName(ctx context.Context, partitionName string) (*craneProtos.PartitionInfo, error) {
 	request := &craneProtos.QueryPartitionInfoRequest{
 		PartitionName: partitionName,
 	}
-	response, err := CraneCtld.QueryPartitionInfo(context.Background(), request)
+	response, err := CraneCtld.QueryPartitionInfo(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -383,14 +429,14 @@ func GetPartitionByName(partitionName string) (*craneProtos.PartitionInfo, error
 	return response.GetPartitionInfoList()[0], nil
 }
 
-func GetTaskByPartitionAndStatus(partitionList []string, statusList []craneProtos.TaskStatus) ([]*craneProtos.TaskInfo, error) {
+func GetTaskByPartitionAndStatus(ctx context.Context, partitionList []string, statusList []craneProtos.TaskStatus) ([]*craneProtos.TaskInfo, error) {
 	req := craneProtos.QueryTasksInfoRequest{
 		FilterPartitions:            partitionList,
 		FilterTaskStates:            statusList,
 		OptionIncludeCompletedTasks: false,
 	}
 
-	response, err := CraneCtld.QueryTasksInfo(context.Background(), &req)
+	response, err := CraneCtld.QueryTasksInfo(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -401,14 +447,14 @@ func GetTaskByPartitionAndStatus(partitionList []string, statusList []craneProto
 	return response.GetTaskInfoList(), nil
 }
 
-func GetTaskByAccountName(accountNames []string) ([]*craneProtos.TaskInfo, error) {
+func GetTaskByAccountName(ctx context.Context, accountNames []string) ([]*craneProtos.TaskInfo, error) {
 	req := craneProtos.QueryTasksInfoRequest{
 		OptionIncludeCompletedTasks: true,
 		FilterAccounts:              accountNames,
 		NumLimit:                    99999999,
 	}
 
-	response, err := CraneCtld.QueryTasksInfo(context.Background(), &req)
+	response, err := CraneCtld.QueryTasksInfo(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -420,7 +466,7 @@ func GetTaskByAccountName(accountNames []string) ([]*craneProtos.TaskInfo, error
 	return response.GetTaskInfoList(), nil
 }
 
-func GetNodeByPartitionAndStatus(partitionList []string, cranedStateList []craneProtos.CranedResourceState) (uint32, error) {
+func GetNodeByPartitionAndStatus(ctx context.Context, partitionList []string, cranedStateList []craneProtos.CranedResourceState) (uint32, error) {
 	var nodeCount uint32
 	controlStateList := []craneProtos.CranedControlState{craneProtos.CranedControlState_CRANE_NONE, craneProtos.CranedControlState_CRANE_DRAIN}
 	req := craneProtos.QueryClusterInfoRequest{
@@ -429,7 +475,7 @@ func GetNodeByPartitionAndStatus(partitionList []string, cranedStateList []crane
 		FilterCranedControlStates:  controlStateList,
 	}
 
-	response, err := CraneCtld.QueryClusterInfo(context.Background(), &req)
+	response, err := CraneCtld.QueryClusterInfo(ctx, &req)
 	if err != nil {
 		return 0, err
 	}
@@ -442,7 +488,7 @@ func GetNodeByPartitionAndStatus(partitionList []string, cranedStateList []crane
 	return nodeCount, nil
 }
 
-func GetNodeByPartition(partitionList []string) (uint32, uint32, uint32, uint32, error) {
+func GetNodeByPartition(ctx context.Context, partitionList []string) (uint32, uint32, uint32, uint32, error) {
 	var idleNodeCount, allocNodeCount, mixNodeCount, downNodeCount uint32
 
 	cranedStateList := []craneProtos.CranedResourceState{craneProtos.CranedResourceState_CRANE_IDLE, craneProtos.CranedResourceState_CRANE_ALLOC, craneProtos.CranedResourceState_CRANE_MIX, craneProtos.CranedResourceState_CRANE_DOWN}
@@ -453,7 +499,7 @@ func GetNodeByPartition(partitionList []string) (uint32, uint32, uint32, uint32,
 		FilterCranedControlStates:  controlStateList,
 	}
 
-	response, err := CraneCtld.QueryClusterInfo(context.Background(), &req)
+	response, err := CraneCtld.QueryClusterInfo(ctx, &req)
 	if err != nil {
 		return idleNodeCount, allocNodeCount, mixNodeCount, downNodeCount, err
 	}
@@ -555,10 +601,10 @@ func SortJobInfo(sortKey string, sortOrder string, jobInfo []*protos.JobInfo) []
 }
 
 // LocalSubmitJob 本地提交cbatch作业函数
-func LocalSubmitJob(scriptString string, username string) (string, error) {
+func LocalSubmitJob(ctx context.Context, scriptString string, username string) (string, error) {
 	// 提交作业命令行
 	cmdLine := fmt.Sprintf("su - %s -c 'cbatch %s'", username, scriptString)
-	cmd := exec.Command("bash", "-c", cmdLine)
+	cmd := exec.CommandContext(ctx, "bash", "-c", cmdLine)
 
 	// 创建一个 bytes.Buffer 用于捕获输出
 	var output bytes.Buffer
@@ -575,9 +621,9 @@ func LocalSubmitJob(scriptString string, username string) (string, error) {
 }
 
 // LocalRunCommandOnNodes executes a command on specific nodes using crun
-func LocalRunCommandOnNodes(nodeList string, command string, username string, timeout time.Duration) (string, string, error) {
+func LocalRunCommandOnNodes(ctx context.Context, nodeList string, command string, username string, timeout time.Duration) (string, string, error) {
 	logrus.Debugf("LocalRunCommandOnNodes params: nodeList=%s, command=%s, username=%s, timeout=%v", nodeList, command, username, timeout)
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	// We need to escape single quotes in the command because they are inside the outer single quotes of su -c
@@ -606,8 +652,8 @@ func LocalRunCommandOnNodes(nodeList string, command string, username string, ti
 }
 
 // RunCommand 简单执行shell命令函数
-func RunCommand(command string) (string, error) {
-	cmd := exec.Command("bash", "-c", command)
+func RunCommand(ctx context.Context, command string) (string, error) {
+	cmd := exec.CommandContext(ctx, "bash", "-c", command)
 
 	// 创建一个 bytes.Buffer 用于捕获输出
 	var output bytes.Buffer
@@ -625,18 +671,17 @@ func RunCommand(command string) (string, error) {
 }
 
 // GetCraneClusterConfig 获取partition的信息, whitelistPartition为空时获取所有分区信息，不为空则获取白名单内的分区信息
-func GetCraneClusterConfig(whitelistPartition, qosList []string) ([]*protos.Partition, error) {
+func GetCraneClusterConfig(ctx context.Context, whitelistPartition, qosList []string) ([]*protos.Partition, error) {
 	var partitions []*protos.Partition
 
-	for _, part := range CConfig.Partitions {
-		if !Contains(whitelistPartition, part.Name) && whitelistPartition != nil {
+	for _, partitionName := range GetAllPartitions(ctx) {
+		if !Contains(whitelistPartition, partitionName) && whitelistPartition != nil {
 			continue
 		}
-		partitionName := part.Name
 		request := &craneProtos.QueryPartitionInfoRequest{
 			PartitionName: partitionName,
 		}
-		response, err := CraneCtld.QueryPartitionInfo(context.Background(), request)
+		response, err := CraneCtld.QueryPartitionInfo(ctx, request)
 		if err != nil {
 			return nil, err
 		}
@@ -657,12 +702,12 @@ func GetCraneClusterConfig(whitelistPartition, qosList []string) ([]*protos.Part
 	return partitions, nil
 }
 
-func GetPartitionDeviceType(partitionName string) (string, error) {
+func GetPartitionDeviceType(ctx context.Context, partitionName string) (string, error) {
 	var deviceType = ""
 	request := &craneProtos.QueryPartitionInfoRequest{
 		PartitionName: partitionName,
 	}
-	response, err := CraneCtld.QueryPartitionInfo(context.Background(), request)
+	response, err := CraneCtld.QueryPartitionInfo(ctx, request)
 	if err != nil {
 		return "", err
 	}
@@ -708,6 +753,10 @@ func ExtractNodeInfo(info *craneProtos.CranedInfo) *protos.NodeInfo {
 	default: // 其他不知道的状态默认为不可用的状态
 		nodeState = protos.NodeInfo_NOT_AVAILABLE
 	}
+	// 排空(DRAIN)的节点不再接收新作业，空闲时视为不可用，排空原因通过MaintenanceService.ListNodeMaintenanceStates查询
+	if info.GetControlState() == craneProtos.CranedControlState_CRANE_DRAIN && nodeState == protos.NodeInfo_IDLE {
+		nodeState = protos.NodeInfo_NOT_AVAILABLE
+	}
 
 	totalMem := info.GetResTotal().GetAllocatableResInNode().GetMemoryLimitBytes() / (1024 * 1024)
 	allocMem := info.GetResAlloc().GetAllocatableResInNode().GetMemoryLimitBytes() / (1024 * 1024)
@@ -773,18 +822,6 @@ func GetGpuNumsFromPartition(data *craneProtos.DeviceMap) uint32 {
 	return uint32(gpuCount)
 }
 
-func GetAllPartitions() []string {
-	if CConfig.Partitions == nil {
-		return nil
-	}
-
-	var partitions []string
-	for _, partition := range CConfig.Partitions {
-		partitions = append(partitions, partition.Name)
-	}
-	return partitions
-}
-
 // GetGpuNumsFromJob 获取加速卡的数量 device_map:{name_type_map:{key:"BI" value:{total:8}}}
 func GetGpuNumsFromJob(data *craneProtos.DeviceMap) int32 {
 	if data == nil {
@@ -817,6 +854,11 @@ func SliceSubtract[T comparable](a, b []T) []T {
 	return result
 }
 
+// SliceIntersect a中同时在b中的元素
+func SliceIntersect[T comparable](a, b []T) []T {
+	return SliceSubtract(a, SliceSubtract(a, b))
+}
+
 func GetUserHomedir(username string) (string, error) {
 	// 获取指定用户名的用户信息
 	u, err := user.Lookup(username)
@@ -829,12 +871,12 @@ func GetUserHomedir(username string) (string, error) {
 	return homeDir, nil
 }
 
-func GetAccountsAuthorizedPartitions(accounts []string) ([]string, error) {
+func GetAccountsAuthorizedPartitions(ctx context.Context, accounts []string) ([]string, error) {
 	var AuthorizedPartitions []string
 	seen := make(map[string]struct{})
 	for _, a := range accounts {
 		// 获取账户信息
-		account, err := GetAccountByName(a)
+		account, err := GetAccountByName(ctx, a)
 		if err != nil {
 			return nil, fmt.Errorf("get accounts: %v failed: %v", a, err)
 		}
@@ -851,7 +893,7 @@ func GetAccountsAuthorizedPartitions(accounts []string) ([]string, error) {
 }
 
 // GetSummaryClusterNodesInfo 获取集群中节点的信息
-func GetSummaryClusterNodesInfo(authorizedPartitions []string) (*ClusterNodesInfo, error) {
+func GetSummaryClusterNodesInfo(ctx context.Context, snapshot *ClusterSnapshot, authorizedPartitions []string) *ClusterNodesInfo {
 	var (
 		nodeCount             uint32
 		runningNodeCount      uint32
@@ -870,17 +912,10 @@ func GetSummaryClusterNodesInfo(authorizedPartitions []string) (*ClusterNodesInf
 		pendingJobCount       uint32
 	)
 
-	request := &craneProtos.QueryCranedInfoRequest{}
-	info, err := CraneCtld.QueryCranedInfo(context.Background(), request)
-	if err != nil {
-		logrus.Errorf("GetClusterNodesInfo failed: %v", err)
-		return nil, err
-	}
-
-	logrus.Tracef("GetClusterNodesInfo nodeInfo%v", info.GetCranedInfoList())
+	logrus.Tracef("GetClusterNodesInfo nodeInfo%v", snapshot.Nodes)
 
 	// 聚合节点统计信息
-	for _, nodeInfo := range info.GetCranedInfoList() {
+	for _, nodeInfo := range snapshot.Nodes {
 		if !anyAuthorized(nodeInfo.PartitionNames, authorizedPartitions) {
 			continue
 		}
@@ -931,10 +966,7 @@ func GetSummaryClusterNodesInfo(authorizedPartitions []string) (*ClusterNodesInf
 	notAvailableCpuCount = cpuCoreCount - runningCpuCount - idleCpuCount
 	notAvailableGpuCount = gpuCoreCount - runningGpuCount - idleGpuCount
 
-	distributionJobs, err := GetJobsStatusDistribution(authorizedPartitions)
-	if err != nil {
-		return nil, err
-	}
+	distributionJobs := GetJobsStatusDistribution(ctx, snapshot, authorizedPartitions)
 	// 聚合作业统计信息
 	for _, jobs := range distributionJobs {
 		totalJobCount += jobs.JobCount
@@ -978,51 +1010,39 @@ func GetSummaryClusterNodesInfo(authorizedPartitions []string) (*ClusterNodesInf
 	}
 
 	logrus.Tracef("GetClusterNodesInfo node Info: %v", result)
-	return result, nil
+	return result
 }
 
-func GetSummaryPartitionsInfo(authorizedPartitions []string) ([]*protos.SummaryPartitionInfo, error) {
+func GetSummaryPartitionsInfo(ctx context.Context, snapshot *ClusterSnapshot, authorizedPartitions []string) []*protos.SummaryPartitionInfo {
 	var partitions []*protos.SummaryPartitionInfo
-	for _, part := range CConfig.Partitions { // 遍历每个计算分区、分别获取信息  分区从接口获取
-		logrus.Infof("GetSummaryPartitionsInfo partition name: %v", part.Name)
-		if !slices.Contains(authorizedPartitions, part.Name) {
+	nodeCounts := snapshot.NodeCountByPartition()
+	jobCounts := snapshot.JobCountByPartition()
+	for _, partitionName := range snapshot.PartitionNames() { // 遍历每个计算分区、分别获取信息  分区从快照获取
+		logrus.Infof("GetSummaryPartitionsInfo partition name: %v", partitionName)
+		if !slices.Contains(authorizedPartitions, partitionName) {
 			continue
 		}
 		var state protos.SummaryPartitionInfo_PartitionStatus
-		// 根据分区名获取分区信息
-		partitionName := part.Name
 
-		partitionInfo, err := GetPartitionByName(partitionName)
-		if err != nil {
-			logrus.Errorf("GetPartitionsInfo failed: %v", err)
-			return nil, fmt.Errorf("get partition info failed: %v", err)
+		partitionInfo, ok := snapshot.Partitions[partitionName]
+		if !ok {
+			logrus.Warnf("GetSummaryPartitionsInfo partition %v not found", partitionName)
+			continue
 		}
 		logrus.Tracef("GetClusterInfo partition info: %v", partitionInfo)
 
-		//// 获取正在运行作业的个数
-		//runningJob, err := GetTaskByPartitionAndStatus([]string{partitionName}, []craneProtos.TaskStatus{craneProtos.TaskStatus_Running})
-		//if err != nil {
-		//	logrus.Errorf("GetClusterInfo failed: %v", err)
-		//	return nil, fmt.Errorf("get running task failed: %v", err)
-		//}
-		//runningJobNum := len(runningJob)
-
-		// 获取正在排队作业的个数
-		pendingJob, err := GetTaskByPartitionAndStatus([]string{partitionName}, []craneProtos.TaskStatus{craneProtos.TaskStatus_Pending})
-		if err != nil {
-			logrus.Errorf("GetClusterInfo failed: %v", err)
-			return nil, fmt.Errorf("get pending task failed: %v", err)
+		var pendingJobNum uint32
+		if jobs, ok := jobCounts[partitionName]; ok {
+			pendingJobNum = jobs.PendingJobCount
 		}
-		pendingJobNum := len(pendingJob)
 
-		idleNodeCount, allocNodeCount, mixNodeCount, downNodeCount, err := GetNodeByPartition([]string{partitionName})
-		if err != nil {
-			logrus.Errorf("GetClusterInfo failed: %v", err)
-			return nil, fmt.Errorf("get pending task failed: %v", err)
+		nodes, ok := nodeCounts[partitionName]
+		if !ok {
+			nodes = &PartitionNodeCount{}
 		}
-		logrus.Tracef("GetClusterInfo idleNodeCount, allocNodeCount, mixNodeCount, downNodeCount: %v, %v, %v, %v", idleNodeCount, allocNodeCount, mixNodeCount, downNodeCount)
+		logrus.Tracef("GetClusterInfo partition %v node count: %+v", partitionName, nodes)
 
-		runningNodes := allocNodeCount + mixNodeCount
+		runningNodes := nodes.Alloc + nodes.Mix
 		if partitionInfo.GetState() == craneProtos.PartitionState_PARTITION_UP {
 			state = protos.SummaryPartitionInfo_AVAILABLE
 		} else {
@@ -1056,12 +1076,12 @@ func GetSummaryPartitionsInfo(authorizedPartitions []string) ([]*protos.SummaryP
 			CpuUsage:        cpuUsage,
 			GpuCoreCount:    TotalGpu,
 			GpuUsage:        gpuUsage,
-			PendingJobCount: uint32(pendingJobNum),
+			PendingJobCount: pendingJobNum,
 			PartitionStatus: state,
 		})
 	}
 
-	return partitions, nil
+	return partitions
 }
 
 // a中有任意一个在b中存在为true，否则为false
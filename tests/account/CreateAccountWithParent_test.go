@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestCreateAccountWithParent(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewAccountExtServiceClient(conn)
+
+	// Call the Add RPC with test data
+	req := &adapterProtos.CreateAccountRequest{
+		AccountName:   "childaccount",
+		OwnerUserId:   "test01",
+		ParentAccount: "parentaccount",
+		Description:   "child account of parentaccount",
+	}
+	_, err = client.CreateAccount(context.Background(), req)
+	if err != nil {
+		t.Fatalf("CreateAccount failed: %v", err)
+	}
+
+	assert.Empty(t, err)
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestDeleteAccountWithOptions(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewAccountExtServiceClient(conn)
+
+	// Call the Add RPC with test data
+	req := &adapterProtos.DeleteAccountWithOptionsRequest{
+		AccountName: "dddd",
+		CancelJobs:  true,
+		SoftDelete:  true,
+	}
+	_, err = client.DeleteAccountWithOptions(context.Background(), req)
+	if err != nil {
+		t.Fatalf("DeleteAccountWithOptions failed: %v", err)
+	}
+
+	assert.Empty(t, err)
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestGetAccountPartitionQos(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewAccountExtServiceClient(conn)
+
+	// Call the Add RPC with test data
+	req := &adapterProtos.GetAccountPartitionQosRequest{
+		AccountName: "dddd",
+	}
+	_, err = client.GetAccountPartitionQos(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetAccountPartitionQos failed: %v", err)
+	}
+
+	assert.Empty(t, err)
+}
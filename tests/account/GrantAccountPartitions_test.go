@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestGrantAccountPartitions(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewAccountExtServiceClient(conn)
+
+	// Call the Add RPC with test data
+	req := &adapterProtos.GrantAccountPartitionsRequest{
+		AccountName: "dddd",
+		Partitions:  []string{"CPU"},
+	}
+	_, err = client.GrantAccountPartitions(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GrantAccountPartitions failed: %v", err)
+	}
+
+	assert.Empty(t, err)
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestListAccountTree(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewAccountExtServiceClient(conn)
+
+	// Call the Add RPC with test data
+	req := &adapterProtos.ListAccountTreeRequest{}
+	_, err = client.ListAccountTree(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ListAccountTree failed: %v", err)
+	}
+
+	assert.Empty(t, err)
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestListEffectiveLimits(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewAccountExtServiceClient(conn)
+
+	// Call the Add RPC with test data
+	req := &adapterProtos.ListEffectiveLimitsRequest{
+		AccountNames: []string{"dddd"},
+	}
+	res, err := client.ListEffectiveLimits(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ListEffectiveLimits failed: %v", err)
+	}
+
+	// Check the result
+	assert.Len(t, res.Accounts, 1)
+	assert.Equal(t, "dddd", res.Accounts[0].AccountName)
+}
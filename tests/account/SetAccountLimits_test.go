@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestSetAccountLimits(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewAccountExtServiceClient(conn)
+
+	// Call the Add RPC with test data
+	req := &adapterProtos.SetAccountLimitsRequest{
+		AccountName: "dddd",
+		Limits: &adapterProtos.ResourceLimits{
+			MaxJobs: proto.Uint32(10),
+			MaxCpus: proto.Uint32(64),
+		},
+	}
+	_, err = client.SetAccountLimits(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SetAccountLimits failed: %v", err)
+	}
+
+	assert.Empty(t, err)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+	protos "scow-crane-adapter/gen/go"
+)
+
+func TestGetPartitionShapes(t *testing.T) {
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewConfigExtServiceClient(conn)
+
+	// Call the Add RPC with test data
+	req := &adapterProtos.GetPartitionShapesRequest{}
+	res, err := client.GetPartitionShapes(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetPartitionShapes failed: %v", err)
+	}
+
+	// Check the result
+	config, err := protos.NewConfigServiceClient(conn).GetClusterConfig(context.Background(), &protos.GetClusterConfigRequest{})
+	if err != nil {
+		t.Fatalf("GetClusterConfig failed: %v", err)
+	}
+	assert.Equal(t, len(config.Partitions), len(res.Partitions))
+}
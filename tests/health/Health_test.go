@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHealth(t *testing.T) {
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	// Check the adapter itself
+	res, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.Status)
+
+	// Check CraneCtld and a service depending on it
+	for _, service := range []string{"cranectld", "scow.scheduler_adapter.JobService"} {
+		res, err = client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("Check %v failed: %v", service, err)
+		}
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, res.Status)
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestListNodeMaintenanceStates(t *testing.T) {
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewMaintenanceServiceClient(conn)
+
+	// Call the Add RPC with test data
+	res, err := client.ListNodeMaintenanceStates(context.Background(), &adapterProtos.ListNodeMaintenanceStatesRequest{})
+	if err != nil {
+		t.Fatalf("ListNodeMaintenanceStates failed: %v", err)
+	}
+
+	// Check the result
+	for _, node := range res.Nodes {
+		assert.NotEmpty(t, node.NodeName)
+	}
+}
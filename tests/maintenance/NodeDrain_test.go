@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestNodeDrain(t *testing.T) {
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewMaintenanceServiceClient(conn)
+
+	// Call the Add RPC with test data
+	res, err := client.ScheduleNodeDrain(context.Background(), &adapterProtos.ScheduleNodeDrainRequest{
+		Nodes:     []string{"crane01"},
+		StartTime: timestamppb.New(time.Now().Add(time.Hour)),
+		Reason:    "test maintenance",
+	})
+	if err != nil {
+		t.Fatalf("ScheduleNodeDrain failed: %v", err)
+	}
+
+	// Check the result
+	list, err := client.ListNodeDrains(context.Background(), &adapterProtos.ListNodeDrainsRequest{})
+	if err != nil {
+		t.Fatalf("ListNodeDrains failed: %v", err)
+	}
+	var found *adapterProtos.NodeDrain
+	for _, drain := range list.Drains {
+		if drain.DrainId == res.DrainId {
+			found = drain
+		}
+	}
+	if assert.NotNil(t, found) {
+		assert.Equal(t, adapterProtos.NodeDrainStatus_DRAIN_PENDING, found.Status)
+	}
+
+	_, err = client.CancelNodeDrain(context.Background(), &adapterProtos.CancelNodeDrainRequest{DrainId: res.DrainId})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestReservation(t *testing.T) {
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewReservationServiceClient(conn)
+
+	// Call the Add RPC with test data
+	_, err = client.CreateReservation(context.Background(), &adapterProtos.CreateReservationRequest{
+		ReservationName: "test_reservation",
+		Partition:       "CPU",
+		NodeList:        "crane01",
+		DurationSeconds: 3600,
+		AllowedAccounts: []string{"a_admin"},
+	})
+	if err != nil {
+		t.Fatalf("CreateReservation failed: %v", err)
+	}
+
+	res, err := client.GetReservation(context.Background(), &adapterProtos.GetReservationRequest{ReservationName: "test_reservation"})
+	if err != nil {
+		t.Fatalf("GetReservation failed: %v", err)
+	}
+	assert.Equal(t, "CPU", res.Reservation.Partition)
+	assert.Equal(t, []string{"a_admin"}, res.Reservation.AllowedAccounts)
+
+	_, err = client.DeleteReservation(context.Background(), &adapterProtos.DeleteReservationRequest{ReservationName: "test_reservation"})
+	if err != nil {
+		t.Fatalf("DeleteReservation failed: %v", err)
+	}
+
+	// Check the result
+	_, err = client.GetReservation(context.Background(), &adapterProtos.GetReservationRequest{ReservationName: "test_reservation"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
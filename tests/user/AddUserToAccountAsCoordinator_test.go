@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestAddUserToAccountAsCoordinator(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewUserExtServiceClient(conn)
+
+	// Call the Add RPC with test data
+	req := &adapterProtos.AddUserToAccountAsCoordinatorRequest{
+		UserId:      "demotest",
+		AccountName: "C_admin",
+	}
+	// 用户已在账户中但不是协调者时返回FAILED_PRECONDITION
+	_, err = client.AddUserToAccountAsCoordinator(context.Background(), req)
+	if err != nil {
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	}
+
+	req.UserId = "not_exist_user"
+	_, err = client.AddUserToAccountAsCoordinator(context.Background(), req)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestBlockUserInAccountWithPartitions(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewUserExtServiceClient(conn)
+
+	// Call the Add RPC with test data
+	req := &adapterProtos.BlockUserInAccountWithPartitionsRequest{
+		UserId:            "demotest",
+		AccountName:       "C_admin",
+		BlockedPartitions: []string{"GPU"},
+	}
+	_, err = client.BlockUserInAccountWithPartitions(context.Background(), req)
+	if err != nil {
+		t.Fatalf("BlockUserInAccountWithPartitions failed: %v", err)
+	}
+
+	resp, err := client.QueryUserInAccountBlockStatusWithPartitions(context.Background(), &adapterProtos.QueryUserInAccountBlockStatusWithPartitionsRequest{
+		UserId:      "demotest",
+		AccountName: "C_admin",
+		Partitions:  []string{"GPU"},
+	})
+	if err != nil {
+		t.Fatalf("QueryUserInAccountBlockStatusWithPartitions failed: %v", err)
+	}
+	assert.True(t, resp.GetUserBlockedDetails()[0].GetBlocked())
+
+	_, err = client.UnblockUserInAccountWithPartitions(context.Background(), &adapterProtos.UnblockUserInAccountWithPartitionsRequest{
+		UserId:              "demotest",
+		AccountName:         "C_admin",
+		UnblockedPartitions: []string{"GPU"},
+	})
+	assert.Empty(t, err)
+}
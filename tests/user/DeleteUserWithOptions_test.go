@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestDeleteUserWithOptions(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewUserExtServiceClient(conn)
+
+	// Call the Add RPC with test data
+	req := &adapterProtos.DeleteUserWithOptionsRequest{
+		UserId: "demotest",
+		Force:  true,
+	}
+	resp, err := client.DeleteUserWithOptions(context.Background(), req)
+	if err != nil {
+		t.Fatalf("DeleteUserWithOptions failed: %v", err)
+	}
+
+	for _, result := range resp.GetAccountResults() {
+		assert.True(t, result.GetSuccess(), result.GetMessage())
+	}
+}
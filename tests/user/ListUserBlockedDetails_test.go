@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestListUserBlockedDetails(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewUserExtServiceClient(conn)
+
+	// Call the Add RPC with test data
+	req := &adapterProtos.ListUserBlockedDetailsRequest{
+		AccountNames: []string{"C_admin"},
+	}
+	res, err := client.ListUserBlockedDetails(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ListUserBlockedDetails failed: %v", err)
+	}
+
+	// Check the result
+	assert.Len(t, res.Accounts, 1)
+	t.Logf("ListUserBlockedDetails users %v", res.Accounts[0].Users)
+}
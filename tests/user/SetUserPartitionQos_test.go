@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	adapterProtos "scow-crane-adapter/gen/adapter"
+)
+
+func TestSetUserPartitionQos(t *testing.T) {
+
+	// Set up a connection to the server
+	conn, err := grpc.Dial("localhost:8972", grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("did not connect: %v", err)
+	}
+	defer conn.Close()
+	client := adapterProtos.NewUserExtServiceClient(conn)
+
+	// Call the Add RPC with test data
+	defaultQos := "test_normal"
+	req := &adapterProtos.SetUserPartitionQosRequest{
+		UserId:      "demotest",
+		AccountName: "C_admin",
+		Override: &adapterProtos.UserPartitionQosOverride{
+			Partitions: []string{"CPU"},
+			QosList:    []string{"test_normal"},
+			DefaultQos: &defaultQos,
+		},
+	}
+	_, err = client.SetUserPartitionQos(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SetUserPartitionQos failed: %v", err)
+	}
+
+	resp, err := client.GetUserPartitionQos(context.Background(), &adapterProtos.GetUserPartitionQosRequest{
+		UserId:      "demotest",
+		AccountName: "C_admin",
+	})
+	if err != nil {
+		t.Fatalf("GetUserPartitionQos failed: %v", err)
+	}
+	assert.Equal(t, []string{"CPU"}, resp.GetOverride().GetPartitions())
+
+	_, err = client.ClearUserPartitionQos(context.Background(), &adapterProtos.ClearUserPartitionQosRequest{
+		UserId:      "demotest",
+		AccountName: "C_admin",
+	})
+	assert.Empty(t, err)
+}